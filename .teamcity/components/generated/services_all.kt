@@ -193,6 +193,7 @@ val services = mapOf(
     "s3" to ServiceSpec("S3 (Simple Storage)"),
     "s3control" to ServiceSpec("S3 Control"),
     "s3outposts" to ServiceSpec("S3 on Outposts"),
+    "s3tables" to ServiceSpec("S3 Tables"),
     "sagemaker" to ServiceSpec("SageMaker", vpcLock = true),
     "scheduler" to ServiceSpec("EventBridge Scheduler"),
     "schemas" to ServiceSpec("EventBridge Schemas"),
@@ -222,6 +223,8 @@ val services = mapOf(
     "sts" to ServiceSpec("STS (Security Token)"),
     "swf" to ServiceSpec("SWF (Simple Workflow)"),
     "synthetics" to ServiceSpec("CloudWatch Synthetics", parallelismOverride = 10),
+    "timestreaminfluxdb" to ServiceSpec("Timestream for InfluxDB"),
+    "timestreamquery" to ServiceSpec("Timestream Query"),
     "timestreamwrite" to ServiceSpec("Timestream Write"),
     "transcribe" to ServiceSpec("Transcribe"),
     "transfer" to ServiceSpec("Transfer Family", vpcLock = true),
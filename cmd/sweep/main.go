@@ -0,0 +1,429 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// sweep deletes test resources left behind by acceptance tests from any number of AWS accounts and Regions.
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path"
+	"slices"
+	"strings"
+	"sync"
+	"sync/atomic"
+	"text/tabwriter"
+	"time"
+
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
+	multierror "github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv1"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+)
+
+const (
+	defaultAssumeRoleDuration = 1 * time.Hour
+	defaultParallelism        = 4
+)
+
+var (
+	dryRun      = flag.Bool("dry-run", false, "list the resources that would be swept without deleting them")
+	exclude     = flag.String("exclude", "", "comma-separated list of resource type patterns to exclude, e.g. aws_iam_*")
+	include     = flag.String("include", "", "comma-separated list of resource type patterns to include (default all)")
+	parallelism = flag.Int("parallelism", defaultParallelism, "maximum number of account and Region combinations swept in parallel")
+	profiles    = flag.String("profiles", "", "comma-separated list of named profiles")
+	regions     = flag.String("regions", "", "comma-separated list of Regions (required)")
+	reportFile  = flag.String("report", "", "path to which a JSON report is written")
+	roleARNs    = flag.String("role-arns", "", "comma-separated list of IAM role ARNs to assume")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tsweep -regions <regions> [-profiles <profiles>] [-role-arns <role-arns>] [-include <patterns>] [-exclude <patterns>] [-dry-run] [-report <file>]\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+func main() {
+	flag.Usage = usage
+	flag.Parse()
+
+	if *regions == "" || *parallelism < 1 {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	ctx := context.Background()
+
+	sweep.ServicePackages = servicePackages(ctx)
+
+	registerSweepers()
+
+	sweepers, err := selectSweepers(sweep.Sweepers(), splitList(*include), splitList(*exclude))
+
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %s\n", err)
+		os.Exit(2)
+	}
+
+	if len(sweepers) == 0 {
+		fmt.Fprintf(os.Stderr, "error: no sweepers match the specified patterns\n")
+		os.Exit(2)
+	}
+
+	r := run(expandTargets(splitList(*profiles), splitList(*roleARNs), splitList(*regions)), sweepers, *dryRun, *parallelism)
+
+	r.writeSummary(os.Stdout)
+
+	if v := *reportFile; v != "" {
+		if err := r.writeJSONFile(v); err != nil {
+			fmt.Fprintf(os.Stderr, "error: writing report: %s\n", err)
+			os.Exit(1)
+		}
+	}
+
+	if r.hasErrors() {
+		os.Exit(1)
+	}
+}
+
+// target is a single account and Region to be swept.
+// The account is identified by a named profile or by an IAM role to assume using the default credentials.
+type target struct {
+	Profile string
+	RoleARN string
+	Region  string
+}
+
+func (t target) config() *conns.Config {
+	conf := &conns.Config{
+		Profile:          t.Profile,
+		Region:           t.Region,
+		RetryMode:        aws_sdkv2.RetryModeAdaptive,
+		SuppressDebugLog: true,
+	}
+
+	if t.RoleARN != "" {
+		conf.AssumeRole = &awsbase.AssumeRole{
+			Duration:    defaultAssumeRoleDuration,
+			RoleARN:     t.RoleARN,
+			SessionName: "terraform-provider-aws-sweep",
+		}
+	}
+
+	return conf
+}
+
+// expandTargets returns all combinations of accounts and Regions.
+// If no profiles or roles are specified, the default credentials are used.
+func expandTargets(profiles, roleARNs, regions []string) []target {
+	var accounts []target
+
+	for _, v := range profiles {
+		accounts = append(accounts, target{Profile: v})
+	}
+	for _, v := range roleARNs {
+		accounts = append(accounts, target{RoleARN: v})
+	}
+
+	if len(accounts) == 0 {
+		accounts = append(accounts, target{})
+	}
+
+	var targets []target
+
+	for _, account := range accounts {
+		for _, region := range regions {
+			account.Region = region
+			targets = append(targets, account)
+		}
+	}
+
+	return targets
+}
+
+// selectSweepers returns the registered sweepers whose names match the include patterns, or all if there are none,
+// and do not match the exclude patterns, together with their registered dependencies.
+// Sweepers are ordered so that each runs after its dependencies.
+func selectSweepers(all map[string]*sweep.Sweeper, include, exclude []string) ([]*sweep.Sweeper, error) {
+	for _, pattern := range append(slices.Clone(include), exclude...) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid pattern %q: %w", pattern, err)
+		}
+	}
+
+	matches := func(patterns []string, name string) bool {
+		return slices.ContainsFunc(patterns, func(pattern string) bool {
+			ok, _ := path.Match(pattern, name)
+			return ok
+		})
+	}
+
+	names := make([]string, 0, len(all))
+	for name := range all {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	const (
+		visiting = iota + 1
+		visited
+	)
+	var (
+		result []*sweep.Sweeper
+		state  = make(map[string]int)
+		visit  func(name string) error
+	)
+
+	visit = func(name string) error {
+		s, ok := all[name]
+		if !ok || matches(exclude, name) {
+			// Dependencies that aren't registered using sweep.Register, or are excluded, are ignored.
+			return nil
+		}
+
+		switch state[name] {
+		case visiting:
+			return fmt.Errorf("sweeper %q has a circular dependency", name)
+		case visited:
+			return nil
+		}
+
+		state[name] = visiting
+		for _, dependency := range s.Dependencies {
+			if err := visit(dependency); err != nil {
+				return err
+			}
+		}
+		state[name] = visited
+
+		result = append(result, s)
+
+		return nil
+	}
+
+	for _, name := range names {
+		if len(include) > 0 && !matches(include, name) {
+			continue
+		}
+
+		if err := visit(name); err != nil {
+			return nil, err
+		}
+	}
+
+	return result, nil
+}
+
+// run sweeps all targets, at most parallelism at a time.
+func run(targets []target, sweepers []*sweep.Sweeper, dryRun bool, parallelism int) *report {
+	r := &report{
+		DryRun:  dryRun,
+		Results: make([][]result, len(targets)),
+	}
+
+	var wg sync.WaitGroup
+	sem := make(chan struct{}, parallelism)
+
+	for i, t := range targets {
+		wg.Add(1)
+		sem <- struct{}{}
+
+		go func() {
+			defer func() {
+				<-sem
+				wg.Done()
+			}()
+
+			r.Results[i] = sweepTarget(t, sweepers, dryRun)
+		}()
+	}
+
+	wg.Wait()
+
+	return r
+}
+
+// sweepTarget runs the specified sweepers, in order, against a single target.
+func sweepTarget(t target, sweepers []*sweep.Sweeper, dryRun bool) []result {
+	ctx := sweep.Context(t.Region)
+
+	client, err := sweep.NewClient(ctx, t.config())
+
+	if err != nil {
+		return []result{{
+			Profile: t.Profile,
+			RoleARN: t.RoleARN,
+			Region:  t.Region,
+			Error:   err.Error(),
+		}}
+	}
+
+	results := make([]result, 0, len(sweepers))
+
+	for _, s := range sweepers {
+		v := result{
+			AccountID:    client.AccountID,
+			Profile:      t.Profile,
+			Region:       t.Region,
+			ResourceType: s.Name,
+			RoleARN:      t.RoleARN,
+		}
+
+		sweepables, err := s.List(ctx, client)
+
+		switch {
+		case awsv1.SkipSweepError(err) || awsv2.SkipSweepError(err):
+			v.Skipped = true
+		case err != nil:
+			v.Error = fmt.Sprintf("listing: %s", err)
+		case dryRun:
+			v.Found = len(sweepables)
+		default:
+			v.Found = len(sweepables)
+			v.Deleted, err = deleteSweepables(ctx, sweepables)
+			if err != nil {
+				v.Error = fmt.Sprintf("sweeping: %s", err)
+			}
+		}
+
+		results = append(results, v)
+	}
+
+	return results
+}
+
+// deleteSweepables deletes the specified resources in parallel, returning the number successfully deleted.
+func deleteSweepables(ctx context.Context, sweepables []sweep.Sweepable) (int, error) {
+	var (
+		deleted atomic.Int64
+		g       multierror.Group
+	)
+
+	for _, sweepable := range sweepables {
+		g.Go(func() error {
+			if err := sweepable.Delete(ctx, sweep.ThrottlingRetryTimeout); err != nil {
+				return err
+			}
+
+			deleted.Add(1)
+
+			return nil
+		})
+	}
+
+	err := g.Wait().ErrorOrNil()
+
+	return int(deleted.Load()), err
+}
+
+// result is the outcome of running a single sweeper against a single target.
+type result struct {
+	AccountID    string `json:"account_id,omitempty"`
+	Deleted      int    `json:"deleted"`
+	Error        string `json:"error,omitempty"`
+	Found        int    `json:"found"`
+	Profile      string `json:"profile,omitempty"`
+	Region       string `json:"region"`
+	ResourceType string `json:"resource_type,omitempty"`
+	RoleARN      string `json:"role_arn,omitempty"`
+	Skipped      bool   `json:"skipped,omitempty"`
+}
+
+type report struct {
+	DryRun  bool
+	Results [][]result // Per target.
+}
+
+func (r *report) all() []result {
+	var results []result
+
+	for _, v := range r.Results {
+		results = append(results, v...)
+	}
+
+	return results
+}
+
+func (r *report) hasErrors() bool {
+	return slices.ContainsFunc(r.all(), func(v result) bool {
+		return v.Error != ""
+	})
+}
+
+// writeSummary writes a summary table of all non-empty results.
+func (r *report) writeSummary(w io.Writer) {
+	tw := tabwriter.NewWriter(w, 0, 0, 2, ' ', 0)
+
+	fmt.Fprintln(tw, "ACCOUNT\tREGION\tRESOURCE TYPE\tFOUND\tDELETED\tSTATUS")
+
+	for _, v := range r.all() {
+		if v.Found == 0 && v.Error == "" {
+			continue
+		}
+
+		account := v.AccountID
+		if account == "" {
+			account = v.account()
+		}
+
+		status := "OK"
+		switch {
+		case v.Error != "":
+			status = "ERROR: " + v.Error
+		case r.DryRun:
+			status = "DRY RUN"
+		}
+
+		fmt.Fprintf(tw, "%s\t%s\t%s\t%d\t%d\t%s\n", account, v.Region, v.ResourceType, v.Found, v.Deleted, status)
+	}
+
+	tw.Flush()
+}
+
+// account returns how the result's account was identified.
+func (v result) account() string {
+	switch {
+	case v.Profile != "":
+		return v.Profile
+	case v.RoleARN != "":
+		return v.RoleARN
+	default:
+		return "default"
+	}
+}
+
+func (r *report) writeJSONFile(filename string) error {
+	b, err := json.MarshalIndent(struct {
+		DryRun  bool     `json:"dry_run"`
+		Results []result `json:"results"`
+	}{
+		DryRun:  r.DryRun,
+		Results: r.all(),
+	}, "", "  ")
+
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(filename, append(b, '\n'), 0644)
+}
+
+func splitList(s string) []string {
+	var list []string
+
+	for _, v := range strings.Split(s, ",") {
+		if v := strings.TrimSpace(v); v != "" {
+			list = append(list, v)
+		}
+	}
+
+	return list
+}
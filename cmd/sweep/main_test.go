@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package main
+
+import (
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+)
+
+func TestSelectSweepers(t *testing.T) {
+	t.Parallel()
+
+	all := map[string]*sweep.Sweeper{
+		"aws_iam_instance_profile":    {Name: "aws_iam_instance_profile", Dependencies: []string{"aws_iam_role"}},
+		"aws_iam_role":                {Name: "aws_iam_role", Dependencies: []string{"aws_iam_service_linked_role", "aws_instance"}},
+		"aws_iam_service_linked_role": {Name: "aws_iam_service_linked_role"},
+		"aws_sqs_queue":               {Name: "aws_sqs_queue"},
+		"aws_xray_group":              {Name: "aws_xray_group"},
+	}
+
+	testCases := map[string]struct {
+		include     []string
+		exclude     []string
+		want        []string
+		expectError bool
+	}{
+		"all": {
+			want: []string{"aws_iam_service_linked_role", "aws_iam_role", "aws_iam_instance_profile", "aws_sqs_queue", "aws_xray_group"},
+		},
+		"include": {
+			include: []string{"aws_iam_instance_profile", "aws_xray_*"},
+			want:    []string{"aws_iam_service_linked_role", "aws_iam_role", "aws_iam_instance_profile", "aws_xray_group"},
+		},
+		"exclude": {
+			exclude: []string{"aws_iam_*"},
+			want:    []string{"aws_sqs_queue", "aws_xray_group"},
+		},
+		"exclude dependency": {
+			include: []string{"aws_iam_role"},
+			exclude: []string{"aws_iam_service_linked_role"},
+			want:    []string{"aws_iam_role"},
+		},
+		"no match": {
+			include: []string{"aws_s3_*"},
+		},
+		"invalid pattern": {
+			include:     []string{"aws_["},
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			sweepers, err := selectSweepers(all, testCase.include, testCase.exclude)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("err = %v, expectError = %t", err, want)
+			}
+
+			var got []string
+			for _, v := range sweepers {
+				got = append(got, v.Name)
+			}
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestSelectSweepersCircularDependency(t *testing.T) {
+	t.Parallel()
+
+	all := map[string]*sweep.Sweeper{
+		"aws_a": {Name: "aws_a", Dependencies: []string{"aws_b"}},
+		"aws_b": {Name: "aws_b", Dependencies: []string{"aws_a"}},
+	}
+
+	if _, err := selectSweepers(all, nil, nil); err == nil {
+		t.Error("expected error")
+	}
+}
+
+func TestExpandTargets(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		profiles []string
+		roleARNs []string
+		want     []target
+	}{
+		"default credentials": {
+			want: []target{
+				{Region: "us-west-2"}, //lintignore:AWSAT003
+				{Region: "us-east-1"}, //lintignore:AWSAT003
+			},
+		},
+		"profiles and roles": {
+			profiles: []string{"dev"},
+			roleARNs: []string{"arn:aws:iam::123456789012:role/sweeper"}, //lintignore:AWSAT005
+			want: []target{
+				{Profile: "dev", Region: "us-west-2"},                                    //lintignore:AWSAT003
+				{Profile: "dev", Region: "us-east-1"},                                    //lintignore:AWSAT003
+				{RoleARN: "arn:aws:iam::123456789012:role/sweeper", Region: "us-west-2"}, //lintignore:AWSAT003,AWSAT005
+				{RoleARN: "arn:aws:iam::123456789012:role/sweeper", Region: "us-east-1"}, //lintignore:AWSAT003,AWSAT005
+			},
+		},
+	}
+
+	for name, testCase := range testCases {
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got := expandTargets(testCase.profiles, testCase.roleARNs, []string{"us-west-2", "us-east-1"}) //lintignore:AWSAT003
+
+			if diff := cmp.Diff(got, testCase.want); diff != "" {
+				t.Errorf("unexpected diff (+want, -got): %s", diff)
+			}
+		})
+	}
+}
@@ -7,7 +7,7 @@ require (
 	github.com/YakDriver/go-version v0.1.0
 	github.com/YakDriver/regexache v0.23.0
 	github.com/aws/aws-sdk-go v1.53.0
-	github.com/aws/aws-sdk-go-v2 v1.26.1
+	github.com/aws/aws-sdk-go-v2 v1.32.7
 	github.com/aws/aws-sdk-go-v2/config v1.27.13
 	github.com/aws/aws-sdk-go-v2/credentials v1.17.13
 	github.com/aws/aws-sdk-go-v2/feature/ec2/imds v1.16.1
@@ -30,12 +30,13 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/auditmanager v1.32.5
 	github.com/aws/aws-sdk-go-v2/service/autoscaling v1.40.6
 	github.com/aws/aws-sdk-go-v2/service/autoscalingplans v1.20.6
+	github.com/aws/aws-sdk-go-v2/service/backup v1.40.1
 	github.com/aws/aws-sdk-go-v2/service/batch v1.37.1
 	github.com/aws/aws-sdk-go-v2/service/bcmdataexports v1.3.5
-	github.com/aws/aws-sdk-go-v2/service/bedrock v1.8.2
-	github.com/aws/aws-sdk-go-v2/service/bedrockagent v1.10.1
+	github.com/aws/aws-sdk-go-v2/service/bedrock v1.25.2
+	github.com/aws/aws-sdk-go-v2/service/bedrockagent v1.33.0
 	github.com/aws/aws-sdk-go-v2/service/budgets v1.23.1
-	github.com/aws/aws-sdk-go-v2/service/chatbot v1.1.6
+	github.com/aws/aws-sdk-go-v2/service/chatbot v1.8.0
 	github.com/aws/aws-sdk-go-v2/service/chimesdkmediapipelines v1.15.6
 	github.com/aws/aws-sdk-go-v2/service/chimesdkvoice v1.15.1
 	github.com/aws/aws-sdk-go-v2/service/cleanrooms v1.12.1
@@ -48,7 +49,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/cloudsearch v1.22.5
 	github.com/aws/aws-sdk-go-v2/service/cloudtrail v1.39.3
 	github.com/aws/aws-sdk-go-v2/service/cloudwatch v1.38.1
-	github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs v1.35.2
+	github.com/aws/aws-sdk-go-v2/service/cloudwatchlogs v1.45.2
 	github.com/aws/aws-sdk-go-v2/service/codeartifact v1.27.1
 	github.com/aws/aws-sdk-go-v2/service/codebuild v1.34.2
 	github.com/aws/aws-sdk-go-v2/service/codecatalyst v1.13.2
@@ -80,7 +81,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/ecr v1.28.0
 	github.com/aws/aws-sdk-go-v2/service/ecrpublic v1.23.5
 	github.com/aws/aws-sdk-go-v2/service/ecs v1.41.8
-	github.com/aws/aws-sdk-go-v2/service/eks v1.42.2
+	github.com/aws/aws-sdk-go-v2/service/eks v1.56.1
 	github.com/aws/aws-sdk-go-v2/service/elasticache v1.38.2
 	github.com/aws/aws-sdk-go-v2/service/elasticbeanstalk v1.23.5
 	github.com/aws/aws-sdk-go-v2/service/elasticloadbalancingv2 v1.30.6
@@ -107,7 +108,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/kinesis v1.27.5
 	github.com/aws/aws-sdk-go-v2/service/kms v1.31.1
 	github.com/aws/aws-sdk-go-v2/service/lakeformation v1.32.1
-	github.com/aws/aws-sdk-go-v2/service/lambda v1.54.1
+	github.com/aws/aws-sdk-go-v2/service/lambda v1.69.3
 	github.com/aws/aws-sdk-go-v2/service/launchwizard v1.3.5
 	github.com/aws/aws-sdk-go-v2/service/lexmodelsv2 v1.43.5
 	github.com/aws/aws-sdk-go-v2/service/lightsail v1.37.1
@@ -119,6 +120,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/mediapackage v1.30.6
 	github.com/aws/aws-sdk-go-v2/service/mediapackagev2 v1.11.1
 	github.com/aws/aws-sdk-go-v2/service/mediastore v1.20.5
+	github.com/aws/aws-sdk-go-v2/service/memorydb v1.25.1
 	github.com/aws/aws-sdk-go-v2/service/mq v1.22.5
 	github.com/aws/aws-sdk-go-v2/service/mwaa v1.26.6
 	github.com/aws/aws-sdk-go-v2/service/neptunegraph v1.8.2
@@ -133,7 +135,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/qbusiness v1.6.1
 	github.com/aws/aws-sdk-go-v2/service/qldb v1.21.5
 	github.com/aws/aws-sdk-go-v2/service/rbin v1.16.5
-	github.com/aws/aws-sdk-go-v2/service/rds v1.78.1
+	github.com/aws/aws-sdk-go-v2/service/rds v1.93.2
 	github.com/aws/aws-sdk-go-v2/service/redshift v1.44.1
 	github.com/aws/aws-sdk-go-v2/service/redshiftdata v1.25.5
 	github.com/aws/aws-sdk-go-v2/service/redshiftserverless v1.18.1
@@ -146,6 +148,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/route53profiles v1.0.2
 	github.com/aws/aws-sdk-go-v2/service/s3 v1.53.2
 	github.com/aws/aws-sdk-go-v2/service/s3control v1.44.7
+	github.com/aws/aws-sdk-go-v2/service/s3tables v1.0.1
 	github.com/aws/aws-sdk-go-v2/service/scheduler v1.8.5
 	github.com/aws/aws-sdk-go-v2/service/secretsmanager v1.28.7
 	github.com/aws/aws-sdk-go-v2/service/securityhub v1.48.1
@@ -166,6 +169,8 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/sts v1.28.7
 	github.com/aws/aws-sdk-go-v2/service/swf v1.22.5
 	github.com/aws/aws-sdk-go-v2/service/synthetics v1.24.5
+	github.com/aws/aws-sdk-go-v2/service/timestreaminfluxdb v1.7.1
+	github.com/aws/aws-sdk-go-v2/service/timestreamquery v1.29.2
 	github.com/aws/aws-sdk-go-v2/service/timestreamwrite v1.25.6
 	github.com/aws/aws-sdk-go-v2/service/transcribe v1.37.1
 	github.com/aws/aws-sdk-go-v2/service/transfer v1.47.3
@@ -178,7 +183,7 @@ require (
 	github.com/aws/aws-sdk-go-v2/service/workspaces v1.39.1
 	github.com/aws/aws-sdk-go-v2/service/workspacesweb v1.18.1
 	github.com/aws/aws-sdk-go-v2/service/xray v1.25.5
-	github.com/aws/smithy-go v1.20.2
+	github.com/aws/smithy-go v1.22.1
 	github.com/beevik/etree v1.3.0
 	github.com/cedar-policy/cedar-go v0.0.0-20240318205125-470d1fe984bb
 	github.com/davecgh/go-spew v1.1.1
@@ -219,6 +224,7 @@ require (
 	golang.org/x/tools v0.18.0
 	gopkg.in/dnaeon/go-vcr.v3 v3.2.0
 	gopkg.in/yaml.v2 v2.4.0
+	gopkg.in/yaml.v3 v3.0.1
 	syreclabs.com/go/faker v1.2.3
 )
 
@@ -229,15 +235,15 @@ require (
 	github.com/agext/levenshtein v1.2.3 // indirect
 	github.com/apparentlymart/go-textseg/v15 v15.0.0 // indirect
 	github.com/armon/go-radix v1.0.0 // indirect
-	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.2 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.5 // indirect
-	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.5 // indirect
+	github.com/aws/aws-sdk-go-v2/aws/protocol/eventstream v1.6.7 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/configsources v1.3.26 // indirect
+	github.com/aws/aws-sdk-go-v2/internal/endpoints/v2 v2.6.26 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/ini v1.8.0 // indirect
 	github.com/aws/aws-sdk-go-v2/internal/v4a v1.3.5 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.11.2 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/accept-encoding v1.12.1 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/checksum v1.3.7 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/endpoint-discovery v1.9.6 // indirect
-	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.11.7 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/endpoint-discovery v1.10.7 // indirect
+	github.com/aws/aws-sdk-go-v2/service/internal/presigned-url v1.12.7 // indirect
 	github.com/aws/aws-sdk-go-v2/service/internal/s3shared v1.17.5 // indirect
 	github.com/aws/aws-sdk-go-v2/service/ssooidc v1.24.0 // indirect
 	github.com/bgentry/speakeasy v0.1.0 // indirect
@@ -292,7 +298,6 @@ require (
 	google.golang.org/grpc v1.63.2 // indirect
 	google.golang.org/protobuf v1.34.0 // indirect
 	gopkg.in/check.v1 v1.0.0-20201130134442-10cb98267c6c // indirect
-	gopkg.in/yaml.v3 v3.0.1 // indirect
 )
 
 replace github.com/hashicorp/terraform-plugin-log => github.com/gdavison/terraform-plugin-log v0.0.0-20230928191232-6c653d8ef8fb
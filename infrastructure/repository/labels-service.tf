@@ -281,6 +281,7 @@ variable "service_labels" {
     "s3",
     "s3control",
     "s3outposts",
+    "s3tables",
     "sagemaker",
     "sagemakera2iruntime",
     "sagemakeredge",
@@ -321,6 +322,7 @@ variable "service_labels" {
     "swf",
     "synthetics",
     "textract",
+    "timestreaminfluxdb",
     "timestreamquery",
     "timestreamwrite",
     "transcribe",
@@ -314,6 +314,18 @@ func CheckDestroyNoop(*terraform.State) error {
 	return nil
 }
 
+// AttrImportStateIdFunc returns an ImportStateIdFunc that uses the value of the specified attribute as the import ID.
+func AttrImportStateIdFunc(resourceName, attrName string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[resourceName]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", resourceName)
+		}
+
+		return rs.Primary.Attributes[attrName], nil
+	}
+}
+
 // CheckSleep returns a TestCheckFunc that pauses the current goroutine for at least the duration d.
 func CheckSleep(t *testing.T, d time.Duration) resource.TestCheckFunc {
 	t.Helper()
@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package acctest
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"slices"
+
+	fwresource "github.com/hashicorp/terraform-plugin-framework/resource"
+	fwschema "github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
+)
+
+// ImportStateVerifyIgnore returns the attributes of the specified resource type whose values cannot be verified on import,
+// i.e. its Sensitive and write-only attributes, together with any additional attributes.
+// The result is suitable for use as a test step's ImportStateVerifyIgnore value.
+func ImportStateVerifyIgnore(ctx context.Context, typeName string, additional ...string) []string {
+	var ignore []string
+
+	if r, ok := Provider.ResourcesMap[typeName]; ok {
+		ignore = sdkImportStateVerifyIgnore("", r.SchemaMap())
+	} else if factory := FrameworkResourceFactory(ctx, typeName); factory != nil {
+		r, err := factory(ctx)
+		if err != nil {
+			panic(fmt.Sprintf("creating resource (%s): %s", typeName, err))
+		}
+
+		response := fwresource.SchemaResponse{}
+		r.Schema(ctx, fwresource.SchemaRequest{}, &response)
+
+		ignore = frameworkImportStateVerifyIgnore(response.Schema.Attributes, response.Schema.Blocks)
+	} else {
+		panic(fmt.Sprintf("resource type not found: %s", typeName))
+	}
+
+	for _, v := range additional {
+		if !slices.Contains(ignore, v) {
+			ignore = append(ignore, v)
+		}
+	}
+
+	slices.Sort(ignore)
+
+	return ignore
+}
+
+// FrameworkResourceFactory returns the factory for the specified Terraform Plugin Framework resource type,
+// or nil if no service package implements the resource type.
+func FrameworkResourceFactory(ctx context.Context, typeName string) func(context.Context) (fwresource.ResourceWithConfigure, error) {
+	meta, ok := Provider.Meta().(*conns.AWSClient)
+	if !ok {
+		return nil
+	}
+
+	for _, sp := range meta.ServicePackages {
+		for _, v := range sp.FrameworkResources(ctx) {
+			r, err := v.Factory(ctx)
+			if err != nil {
+				continue
+			}
+
+			response := fwresource.MetadataResponse{}
+			r.Metadata(ctx, fwresource.MetadataRequest{}, &response)
+
+			if response.TypeName == typeName {
+				return v.Factory
+			}
+		}
+	}
+
+	return nil
+}
+
+// sdkImportStateVerifyIgnore returns the Sensitive and write-only attributes in a Terraform Plugin SDK schema.
+// Nested attributes are returned individually for blocks with at most one element and otherwise as their enclosing block.
+func sdkImportStateVerifyIgnore(prefix string, s map[string]*schema.Schema) []string {
+	var ignore []string
+
+	for k, v := range s {
+		path := prefix + k
+
+		if v.Sensitive || isWriteOnly(v) {
+			ignore = append(ignore, path)
+			continue
+		}
+
+		elem, ok := v.Elem.(*schema.Resource)
+		if !ok {
+			continue
+		}
+
+		if v.Type == schema.TypeList && v.MaxItems == 1 {
+			ignore = append(ignore, sdkImportStateVerifyIgnore(path+".0.", elem.SchemaMap())...)
+		} else if len(sdkImportStateVerifyIgnore("", elem.SchemaMap())) > 0 {
+			ignore = append(ignore, path)
+		}
+	}
+
+	return ignore
+}
+
+// isWriteOnly returns whether the specified Terraform Plugin SDK attribute is write-only.
+func isWriteOnly(s *schema.Schema) bool {
+	if s.DiffSuppressFunc == nil {
+		return false
+	}
+
+	return reflect.ValueOf(s.DiffSuppressFunc).Pointer() == reflect.ValueOf(sdkv2.SuppressWriteOnly).Pointer()
+}
+
+// frameworkImportStateVerifyIgnore returns the Sensitive attributes in a Terraform Plugin Framework schema.
+// Nested attributes are returned as their enclosing attribute or block.
+func frameworkImportStateVerifyIgnore(attributes map[string]fwschema.Attribute, blocks map[string]fwschema.Block) []string {
+	var ignore []string
+
+	for k, v := range attributes {
+		if v.IsSensitive() {
+			ignore = append(ignore, k)
+			continue
+		}
+
+		var nested map[string]fwschema.Attribute
+		switch v := v.(type) {
+		case fwschema.ListNestedAttribute:
+			nested = v.NestedObject.Attributes
+		case fwschema.MapNestedAttribute:
+			nested = v.NestedObject.Attributes
+		case fwschema.SetNestedAttribute:
+			nested = v.NestedObject.Attributes
+		case fwschema.SingleNestedAttribute:
+			nested = v.Attributes
+		}
+
+		if len(frameworkImportStateVerifyIgnore(nested, nil)) > 0 {
+			ignore = append(ignore, k)
+		}
+	}
+
+	for k, v := range blocks {
+		var (
+			nestedAttributes map[string]fwschema.Attribute
+			nestedBlocks     map[string]fwschema.Block
+		)
+		switch v := v.(type) {
+		case fwschema.ListNestedBlock:
+			nestedAttributes, nestedBlocks = v.NestedObject.Attributes, v.NestedObject.Blocks
+		case fwschema.SetNestedBlock:
+			nestedAttributes, nestedBlocks = v.NestedObject.Attributes, v.NestedObject.Blocks
+		case fwschema.SingleNestedBlock:
+			nestedAttributes, nestedBlocks = v.Attributes, v.Blocks
+		}
+
+		if len(frameworkImportStateVerifyIgnore(nestedAttributes, nestedBlocks)) > 0 {
+			ignore = append(ignore, k)
+		}
+	}
+
+	return ignore
+}
@@ -39,8 +39,11 @@ type AWSClient struct {
 	awsConfig                 *aws_sdkv2.Config
 	clients                   map[string]any
 	conns                     map[string]any
+	dataSourceMemoizer        *dataSourceMemoizer // From provider configuration.
 	dnsSuffix                 string
 	endpoints                 map[string]string // From provider configuration.
+	endpointURL               string            // From provider configuration.
+	endpointURLTemplate       string            // From provider configuration.
 	httpClient                *http.Client
 	lock                      sync.Mutex
 	logger                    baselogging.Logger
@@ -232,6 +235,9 @@ func (c *AWSClient) ReverseDNSPrefix(ctx context.Context) string {
 // EC2RegionalPrivateDNSSuffix returns the EC2 private DNS suffix for the configured AWS Region.
 func (c *AWSClient) EC2RegionalPrivateDNSSuffix(context.Context) string {
 	region := c.Region
+	if v, ok := names.EC2PrivateDNSSuffixForCustomRegion(region); ok {
+		return v
+	}
 	if region == names.USEast1RegionID {
 		return "ec2.internal"
 	}
@@ -293,6 +299,23 @@ func (c *AWSClient) resolveEndpoint(ctx context.Context, servicePackageName stri
 		return endpoint
 	}
 
+	// A provider-level base endpoint overrides the AWS_ENDPOINT_URL envvar and shared config file `endpoint_url`,
+	// but not service-specific endpoints configured via envvar or shared config file.
+	if base := expandEndpointURL(servicePackageName, c.Region, c.endpointURL, c.endpointURLTemplate); base != "" {
+		if names.ClientSDKV1(servicePackageName) {
+			if svc := os.Getenv(names.AWSServiceEnvVar(servicePackageName)); svc != "" {
+				return svc
+			}
+		}
+
+		sdkID := names.SDKID(servicePackageName)
+		if endpoint, found, err := resolveServiceBaseEndpoint(ctx, sdkID, c.awsConfig.ConfigSources); found && err == nil {
+			return endpoint
+		}
+
+		return base
+	}
+
 	// Only continue if there is an SDK v1 package. SDK v2 supports envvars and config file
 	if names.ClientSDKV1(servicePackageName) {
 		endpoint = aws_sdkv2.ToString(c.awsConfig.BaseEndpoint)
@@ -316,6 +339,32 @@ func (c *AWSClient) resolveEndpoint(ctx context.Context, servicePackageName stri
 	return endpoint
 }
 
+// expandEndpointURL returns the endpoint for the specified service derived from the provider-level
+// `endpoint_url_template` or `endpoint_url` configuration.
+// The template placeholders `{service}` and `{region}` are replaced with the service's endpoint URL ID and AWS Region.
+func expandEndpointURL(servicePackageName, region, endpointURL, endpointURLTemplate string) string {
+	if endpointURLTemplate != "" {
+		return strings.NewReplacer(
+			"{service}", endpointURLServiceID(servicePackageName),
+			"{region}", region,
+		).Replace(endpointURLTemplate)
+	}
+
+	return endpointURL
+}
+
+// endpointURLServiceID returns the identifier substituted for `{service}` in an endpoint URL template.
+// It is the service's AWS SDK for Go v2 service ID, lower-cased and with spaces replaced by hyphens,
+// e.g. `cognito-identity-provider` for the `cognitoidp` service package.
+func endpointURLServiceID(servicePackageName string) string {
+	sdkID := names.SDKID(servicePackageName)
+	if sdkID == "" {
+		return servicePackageName
+	}
+
+	return strings.ReplaceAll(strings.ToLower(sdkID), " ", "-")
+}
+
 // serviceBaseEndpointProvider is needed to search for all providers
 // that provide a configured service endpoint
 type serviceBaseEndpointProvider interface {
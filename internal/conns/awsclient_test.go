@@ -6,6 +6,8 @@ package conns
 import (
 	"context"
 	"testing"
+
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func TestAWSClientPartitionHostname(t *testing.T) { // nosemgrep:ci.aws-in-func-name
@@ -181,3 +183,73 @@ func TestAWSClientEC2PublicDNSNameForIP(t *testing.T) { // nosemgrep:ci.aws-in-f
 		})
 	}
 }
+
+func TestExpandEndpointURL(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		Name                string
+		ServicePackageName  string
+		Region              string
+		EndpointURL         string
+		EndpointURLTemplate string
+		Expected            string
+	}{
+		{
+			Name:               "no configuration",
+			ServicePackageName: names.DynamoDB,
+			Region:             names.USWest2RegionID,
+			Expected:           "",
+		},
+		{
+			Name:               "endpoint URL",
+			ServicePackageName: names.DynamoDB,
+			Region:             names.USWest2RegionID,
+			EndpointURL:        "http://localhost:4566",
+			Expected:           "http://localhost:4566",
+		},
+		{
+			Name:                "endpoint URL template",
+			ServicePackageName:  names.DynamoDB,
+			Region:              names.USWest2RegionID,
+			EndpointURLTemplate: "https://{service}.{region}.example.com",
+			Expected:            "https://dynamodb.us-west-2.example.com", //lintignore:AWSAT003
+		},
+		{
+			Name:                "endpoint URL template renamed service",
+			ServicePackageName:  names.CognitoIDP,
+			Region:              names.USWest2RegionID,
+			EndpointURLTemplate: "https://{service}.{region}.example.com",
+			Expected:            "https://cognito-identity-provider.us-west-2.example.com", //lintignore:AWSAT003
+		},
+		{
+			Name:                "endpoint URL template multi-word service",
+			ServicePackageName:  names.ELBV2,
+			Region:              names.USWest2RegionID,
+			EndpointURLTemplate: "https://{service}.{region}.example.com",
+			Expected:            "https://elastic-load-balancing-v2.us-west-2.example.com", //lintignore:AWSAT003
+		},
+		{
+			Name:                "endpoint URL template takes precedence",
+			ServicePackageName:  names.DynamoDB,
+			Region:              names.USWest2RegionID,
+			EndpointURL:         "http://localhost:4566",
+			EndpointURLTemplate: "https://{service}.{region}.example.com",
+			Expected:            "https://dynamodb.us-west-2.example.com", //lintignore:AWSAT003
+		},
+	}
+
+	for _, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(testCase.Name, func(t *testing.T) {
+			t.Parallel()
+
+			got := expandEndpointURL(testCase.ServicePackageName, testCase.Region, testCase.EndpointURL, testCase.EndpointURLTemplate)
+
+			if got != testCase.Expected {
+				t.Errorf("got %s, expected %s", got, testCase.Expected)
+			}
+		})
+	}
+}
@@ -6,6 +6,7 @@ package conns
 import (
 	"context"
 	"fmt"
+	"os"
 	"strings"
 	"time"
 
@@ -33,9 +34,12 @@ type Config struct {
 	AssumeRoleWithWebIdentity      *awsbase.AssumeRoleWithWebIdentity
 	CustomCABundle                 string
 	DefaultTagsConfig              *tftags.DefaultConfig
+	DistributedLock                *DistributedLockConfig
 	EC2MetadataServiceEnableState  imds_sdkv2.ClientEnableState
 	EC2MetadataServiceEndpoint     string
 	EC2MetadataServiceEndpointMode string
+	EndpointURL                    string
+	EndpointURLTemplate            string
 	Endpoints                      map[string]string
 	ForbiddenAccountIds            []string
 	HTTPProxy                      *string
@@ -43,7 +47,9 @@ type Config struct {
 	IgnoreTagsConfig               *tftags.IgnoreConfig
 	Insecure                       bool
 	MaxRetries                     int
+	MemoizeDataSources             *MemoizeDataSourcesConfig
 	NoProxy                        string
+	PartitionsFile                 string
 	Profile                        string
 	Region                         string
 	RetryMode                      aws_sdkv2.RetryMode
@@ -70,6 +76,29 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 
 	ctx, logger := logging.NewTfLogger(ctx)
 
+	partitionsFile := c.PartitionsFile
+	if partitionsFile == "" {
+		partitionsFile = os.Getenv(names.PartitionsFileEnvVar)
+	}
+	if partitionsFile != "" {
+		if err := names.LoadPartitionsFile(partitionsFile); err != nil {
+			return nil, sdkdiag.AppendFromErr(diags, err)
+		}
+	}
+
+	// Endpoints used by aws-sdk-go-base during credential validation must honor any provider-level base endpoint.
+	baseEndpoints := make(map[string]string)
+	for _, servicePackageName := range []string{names.IAM, names.SSO, names.STS} {
+		if v := c.Endpoints[servicePackageName]; v != "" {
+			baseEndpoints[servicePackageName] = v
+			continue
+		}
+		if os.Getenv(names.AWSServiceEnvVar(servicePackageName)) != "" {
+			continue
+		}
+		baseEndpoints[servicePackageName] = expandEndpointURL(servicePackageName, c.Region, c.EndpointURL, c.EndpointURLTemplate)
+	}
+
 	const (
 		maxBackoff = 300 * time.Second // AWS SDK for Go v1 DefaultRetryerMaxRetryDelay: https://github.com/aws/aws-sdk-go/blob/9f6e3bb9f523aef97fa1cd5c5f8ba8ecf212e44e/aws/client/default_retryer.go#L48-L49.
 	)
@@ -89,7 +118,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		CallerName:                     "Terraform AWS Provider",
 		EC2MetadataServiceEnableState:  c.EC2MetadataServiceEnableState,
 		ForbiddenAccountIds:            c.ForbiddenAccountIds,
-		IamEndpoint:                    c.Endpoints[names.IAM],
+		IamEndpoint:                    baseEndpoints[names.IAM],
 		Insecure:                       c.Insecure,
 		HTTPClient:                     client.HTTPClient(ctx),
 		HTTPProxy:                      c.HTTPProxy,
@@ -105,8 +134,8 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		SecretKey:                      c.SecretKey,
 		SkipCredsValidation:            c.SkipCredsValidation,
 		SkipRequestingAccountId:        c.SkipRequestingAccountId,
-		SsoEndpoint:                    c.Endpoints[names.SSO],
-		StsEndpoint:                    c.Endpoints[names.STS],
+		SsoEndpoint:                    baseEndpoints[names.SSO],
+		StsEndpoint:                    baseEndpoints[names.STS],
 		SuppressDebugLog:               c.SuppressDebugLog,
 		Token:                          c.Token,
 		TokenBucketRateLimiterCapacity: c.TokenBucketRateLimiterCapacity,
@@ -159,7 +188,7 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 		return nil, diags
 	}
 
-	if !c.SkipRegionValidation {
+	if !c.SkipRegionValidation && !names.IsCustomRegion(cfg.Region) {
 		if err := basevalidation.SupportedRegion(cfg.Region); err != nil {
 			return nil, sdkdiag.AppendFromErr(diags, err)
 		}
@@ -205,7 +234,10 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	}
 
 	dnsSuffix := "amazonaws.com"
-	if p, ok := endpoints_sdkv1.PartitionForRegion(endpoints_sdkv1.DefaultPartitions(), c.Region); ok {
+	if names.IsCustomRegion(c.Region) {
+		partition = names.PartitionForRegion(c.Region)
+		dnsSuffix = names.DNSSuffixForPartition(partition)
+	} else if p, ok := endpoints_sdkv1.PartitionForRegion(endpoints_sdkv1.DefaultPartitions(), c.Region); ok {
 		dnsSuffix = p.DNSSuffix()
 	}
 
@@ -223,11 +255,23 @@ func (c *Config) ConfigureProvider(ctx context.Context, client *AWSClient) (*AWS
 	client.clients = make(map[string]any, 0)
 	client.conns = make(map[string]any, 0)
 	client.endpoints = c.Endpoints
+	client.endpointURL = c.EndpointURL
+	client.endpointURLTemplate = c.EndpointURLTemplate
 	client.logger = logger
 	client.s3UsePathStyle = c.S3UsePathStyle
 	client.s3USEast1RegionalEndpoint = c.S3USEast1RegionalEndpoint
 	client.stsRegion = c.STSRegion
 
+	if c.MemoizeDataSources != nil {
+		client.dataSourceMemoizer = newDataSourceMemoizer(c.MemoizeDataSources)
+	}
+
+	if c.DistributedLock != nil {
+		if err := configureDistributedLock(ctx, c.DistributedLock, client); err != nil {
+			return nil, sdkdiag.AppendErrorf(diags, "configuring distributed lock: %s", err)
+		}
+	}
+
 	return client, diags
 }
 
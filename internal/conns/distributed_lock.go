@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+)
+
+const (
+	DefaultDistributedLockLeaseDuration = 30 * time.Second
+	MinDistributedLockLeaseDuration     = 5 * time.Second
+
+	defaultDistributedLockPollInterval = 1 * time.Second
+)
+
+// DistributedLockConfig configures the backend used to serialize GlobalMutexKV locks across provider processes.
+// Exactly one of DynamoDBTable or Directory must be set.
+type DistributedLockConfig struct {
+	Directory     string
+	DynamoDBTable string
+	LeaseDuration time.Duration
+}
+
+// distributedLocker is implemented by distributed lock backends.
+// Leases are identified by key and owned by the locker that acquired them.
+type distributedLocker interface {
+	// tryLock acquires, or renews, the lease on the given key until the specified time.
+	// It returns false if the lease is held by another owner.
+	tryLock(ctx context.Context, key string, expires time.Time) (bool, error)
+	// unlock releases the lease on the given key if it is held by this owner.
+	unlock(ctx context.Context, key string) error
+}
+
+// configureDistributedLock configures GlobalMutexKV to use the specified distributed lock backend.
+func configureDistributedLock(ctx context.Context, c *DistributedLockConfig, client *AWSClient) error {
+	owner, err := newDistributedLockOwner()
+
+	if err != nil {
+		return err
+	}
+
+	var locker distributedLocker
+	switch {
+	case c.DynamoDBTable != "":
+		locker = newDynamoDBLocker(client.DynamoDBClient(ctx), c.DynamoDBTable, owner)
+	case c.Directory != "":
+		if err := os.MkdirAll(c.Directory, 0o700); err != nil {
+			return fmt.Errorf("creating distributed lock directory (%s): %w", c.Directory, err)
+		}
+
+		locker = newFileLocker(c.Directory, owner)
+	default:
+		return fmt.Errorf("one of DynamoDB table or directory must be configured for distributed locking")
+	}
+
+	leaseDuration := c.LeaseDuration
+	if leaseDuration == 0 {
+		leaseDuration = DefaultDistributedLockLeaseDuration
+	}
+
+	GlobalMutexKV.setDistributedLocker(locker, leaseDuration)
+
+	return nil
+}
+
+// newDistributedLockOwner returns a unique identifier for this provider process's leases.
+func newDistributedLockOwner() (string, error) {
+	hostname, err := os.Hostname()
+
+	if err != nil {
+		return "", fmt.Errorf("reading hostname: %w", err)
+	}
+
+	return fmt.Sprintf("%s:%d:%s", hostname, os.Getpid(), id.UniqueId()), nil
+}
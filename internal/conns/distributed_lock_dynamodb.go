@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"strconv"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+)
+
+const (
+	// The table's partition key is compatible with the Terraform S3 backend's state locking table.
+	dynamoDBLockIDAttribute      = "LockID"
+	dynamoDBLockOwnerAttribute   = "Owner"
+	dynamoDBLockExpiresAttribute = "Expires"
+
+	dynamoDBLockIDPrefix = "terraform-provider-aws/"
+)
+
+// dynamoDBLockAPI is the subset of the DynamoDB API used by dynamoDBLocker.
+type dynamoDBLockAPI interface {
+	DeleteItem(context.Context, *dynamodb.DeleteItemInput, ...func(*dynamodb.Options)) (*dynamodb.DeleteItemOutput, error)
+	PutItem(context.Context, *dynamodb.PutItemInput, ...func(*dynamodb.Options)) (*dynamodb.PutItemOutput, error)
+}
+
+// dynamoDBLocker implements distributed locking using conditional writes to a DynamoDB table.
+// Lease expiry times are stored as epoch seconds so that the table's Time to Live can remove abandoned leases.
+type dynamoDBLocker struct {
+	conn  dynamoDBLockAPI
+	owner string
+	table string
+}
+
+func newDynamoDBLocker(conn dynamoDBLockAPI, table, owner string) *dynamoDBLocker {
+	return &dynamoDBLocker{
+		conn:  conn,
+		owner: owner,
+		table: table,
+	}
+}
+
+func (l *dynamoDBLocker) tryLock(ctx context.Context, key string, expires time.Time) (bool, error) {
+	input := &dynamodb.PutItemInput{
+		ConditionExpression: aws.String("attribute_not_exists(#id) OR #owner = :owner OR #expires < :now"),
+		ExpressionAttributeNames: map[string]string{
+			"#expires": dynamoDBLockExpiresAttribute,
+			"#id":      dynamoDBLockIDAttribute,
+			"#owner":   dynamoDBLockOwnerAttribute,
+		},
+		ExpressionAttributeValues: map[string]awstypes.AttributeValue{
+			":now":   &awstypes.AttributeValueMemberN{Value: strconv.FormatInt(time.Now().Unix(), 10)},
+			":owner": &awstypes.AttributeValueMemberS{Value: l.owner},
+		},
+		Item: map[string]awstypes.AttributeValue{
+			dynamoDBLockExpiresAttribute: &awstypes.AttributeValueMemberN{Value: strconv.FormatInt(expires.Unix(), 10)},
+			dynamoDBLockIDAttribute:      &awstypes.AttributeValueMemberS{Value: dynamoDBLockIDPrefix + key},
+			dynamoDBLockOwnerAttribute:   &awstypes.AttributeValueMemberS{Value: l.owner},
+		},
+		TableName: aws.String(l.table),
+	}
+
+	_, err := l.conn.PutItem(ctx, input)
+
+	if errs.IsA[*awstypes.ConditionalCheckFailedException](err) {
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (l *dynamoDBLocker) unlock(ctx context.Context, key string) error {
+	input := &dynamodb.DeleteItemInput{
+		ConditionExpression: aws.String("#owner = :owner"),
+		ExpressionAttributeNames: map[string]string{
+			"#owner": dynamoDBLockOwnerAttribute,
+		},
+		ExpressionAttributeValues: map[string]awstypes.AttributeValue{
+			":owner": &awstypes.AttributeValueMemberS{Value: l.owner},
+		},
+		Key: map[string]awstypes.AttributeValue{
+			dynamoDBLockIDAttribute: &awstypes.AttributeValueMemberS{Value: dynamoDBLockIDPrefix + key},
+		},
+		TableName: aws.String(l.table),
+	}
+
+	_, err := l.conn.DeleteItem(ctx, input)
+
+	// The lease has expired and been taken by another owner.
+	if errs.IsA[*awstypes.ConditionalCheckFailedException](err) {
+		return nil
+	}
+
+	return err
+}
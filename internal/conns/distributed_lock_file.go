@@ -0,0 +1,160 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// fileLocker implements distributed locking between provider processes on a single host using lock files in a directory.
+// Each lock file records its owner and lease expiry time.
+// Lock files are created atomically by hard linking a fully written temporary file.
+// Taking over an expired lease is best effort: two processes racing to take over the same expired lease may both succeed.
+type fileLocker struct {
+	directory string
+	owner     string
+}
+
+func newFileLocker(directory, owner string) *fileLocker {
+	return &fileLocker{
+		directory: directory,
+		owner:     owner,
+	}
+}
+
+func (l *fileLocker) tryLock(_ context.Context, key string, expires time.Time) (bool, error) {
+	path := l.path(key)
+
+	tmp, err := l.writeTemp(path, expires)
+
+	if err != nil {
+		return false, err
+	}
+
+	defer os.Remove(tmp)
+
+	err = os.Link(tmp, path)
+
+	if err == nil {
+		return true, nil
+	}
+
+	if !errors.Is(err, fs.ErrExist) {
+		return false, fmt.Errorf("creating lock file (%s): %w", path, err)
+	}
+
+	owner, leaseExpires, err := readLockFile(path)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		// Released in the meantime.
+		return false, nil
+	}
+
+	if err != nil {
+		return false, err
+	}
+
+	if owner != l.owner && time.Now().Before(leaseExpires) {
+		return false, nil
+	}
+
+	// Renew our lease or take over an expired lease.
+	if err := os.Rename(tmp, path); err != nil {
+		return false, fmt.Errorf("replacing lock file (%s): %w", path, err)
+	}
+
+	if owner, _, err := readLockFile(path); err != nil || owner != l.owner {
+		return false, err
+	}
+
+	return true, nil
+}
+
+func (l *fileLocker) unlock(_ context.Context, key string) error {
+	path := l.path(key)
+
+	owner, _, err := readLockFile(path)
+
+	if errors.Is(err, fs.ErrNotExist) {
+		return nil
+	}
+
+	if err != nil {
+		return err
+	}
+
+	// The lease has expired and been taken by another owner.
+	if owner != l.owner {
+		return nil
+	}
+
+	if err := os.Remove(path); err != nil && !errors.Is(err, fs.ErrNotExist) {
+		return fmt.Errorf("removing lock file (%s): %w", path, err)
+	}
+
+	return nil
+}
+
+// path returns the lock file path for the given key. Keys are hashed as they may contain path separators.
+func (l *fileLocker) path(key string) string {
+	sum := sha256.Sum256([]byte(key))
+
+	return filepath.Join(l.directory, hex.EncodeToString(sum[:])+".lock")
+}
+
+// writeTemp writes this owner's lease to a new temporary file alongside the lock file.
+func (l *fileLocker) writeTemp(path string, expires time.Time) (string, error) {
+	f, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".*")
+
+	if err != nil {
+		return "", fmt.Errorf("creating temporary lock file: %w", err)
+	}
+
+	_, err = fmt.Fprintf(f, "%s\n%d\n", l.owner, expires.UnixNano())
+
+	if closeErr := f.Close(); err == nil {
+		err = closeErr
+	}
+
+	if err != nil {
+		os.Remove(f.Name())
+
+		return "", fmt.Errorf("writing temporary lock file: %w", err)
+	}
+
+	return f.Name(), nil
+}
+
+// readLockFile returns the owner and lease expiry time recorded in a lock file.
+func readLockFile(path string) (string, time.Time, error) {
+	b, err := os.ReadFile(path)
+
+	if err != nil {
+		return "", time.Time{}, err
+	}
+
+	owner, expires, ok := strings.Cut(strings.TrimSpace(string(b)), "\n")
+
+	if !ok {
+		return "", time.Time{}, fmt.Errorf("invalid lock file (%s)", path)
+	}
+
+	nsec, err := strconv.ParseInt(expires, 10, 64)
+
+	if err != nil {
+		return "", time.Time{}, fmt.Errorf("invalid lock file (%s): %w", path, err)
+	}
+
+	return owner, time.Unix(0, nsec), nil
+}
@@ -0,0 +1,162 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/credentials"
+	"github.com/aws/aws-sdk-go-v2/service/dynamodb"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/dynamodb/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+)
+
+func TestFileLocker(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+
+	testDistributedLocker(t, newFileLocker(directory, "owner1"), newFileLocker(directory, "owner2"))
+}
+
+// TestDynamoDBLocker runs against a local DynamoDB stand-in, e.g. DynamoDB Local, at the endpoint in DYNAMODB_LOCAL_ENDPOINT.
+func TestDynamoDBLocker(t *testing.T) {
+	t.Parallel()
+
+	endpoint := os.Getenv("DYNAMODB_LOCAL_ENDPOINT")
+	if endpoint == "" {
+		t.Skip("DYNAMODB_LOCAL_ENDPOINT must be set for DynamoDB distributed lock tests")
+	}
+
+	ctx := context.Background()
+	conn := dynamodb.New(dynamodb.Options{
+		BaseEndpoint: aws.String(endpoint),
+		Credentials:  credentials.NewStaticCredentialsProvider("test", "test", ""),
+		Region:       "us-west-2", //lintignore:AWSAT003
+	})
+	table := sdkacctest.RandomWithPrefix("tf-acc-test")
+
+	_, err := conn.CreateTable(ctx, &dynamodb.CreateTableInput{
+		AttributeDefinitions: []awstypes.AttributeDefinition{{
+			AttributeName: aws.String(dynamoDBLockIDAttribute),
+			AttributeType: awstypes.ScalarAttributeTypeS,
+		}},
+		BillingMode: awstypes.BillingModePayPerRequest,
+		KeySchema: []awstypes.KeySchemaElement{{
+			AttributeName: aws.String(dynamoDBLockIDAttribute),
+			KeyType:       awstypes.KeyTypeHash,
+		}},
+		TableName: aws.String(table),
+	})
+	if err != nil {
+		t.Fatalf("creating table: %s", err)
+	}
+
+	t.Cleanup(func() {
+		conn.DeleteTable(ctx, &dynamodb.DeleteTableInput{ //nolint:errcheck // Best effort.
+			TableName: aws.String(table),
+		})
+	})
+
+	testDistributedLocker(t, newDynamoDBLocker(conn, table, "owner1"), newDynamoDBLocker(conn, table, "owner2"))
+}
+
+func TestMutexKVDistributedLock(t *testing.T) {
+	t.Parallel()
+
+	directory := t.TempDir()
+
+	// Simulate two provider processes.
+	mkv1, mkv2 := newMutexKV(), newMutexKV()
+	for i, mkv := range []*mutexKV{mkv1, mkv2} {
+		mkv.pollInterval = 10 * time.Millisecond
+		mkv.setDistributedLocker(newFileLocker(directory, fmt.Sprintf("owner%d", i)), 3*time.Second)
+	}
+
+	mkv1.Lock("foo")
+
+	doneCh := make(chan struct{})
+
+	go func() {
+		mkv2.Lock("foo")
+		close(doneCh)
+	}()
+
+	select {
+	case <-doneCh:
+		t.Fatal("Second lock was able to be taken. This shouldn't happen.")
+	case <-time.After(100 * time.Millisecond):
+		// pass
+	}
+
+	mkv1.Unlock("foo")
+
+	select {
+	case <-doneCh:
+		// pass
+	case <-time.After(1 * time.Second):
+		t.Fatal("Second lock blocked after unlock. This shouldn't happen.")
+	}
+
+	mkv2.Unlock("foo")
+}
+
+func testDistributedLocker(t *testing.T, locker1, locker2 distributedLocker) {
+	t.Helper()
+
+	ctx := context.Background()
+	key := "sg-12345678"
+
+	tryLock := func(locker distributedLocker, expires time.Time, want bool) {
+		t.Helper()
+
+		got, err := locker.tryLock(ctx, key, expires)
+
+		if err != nil {
+			t.Fatalf("tryLock: %s", err)
+		}
+
+		if got != want {
+			t.Fatalf("tryLock: got %t, want %t", got, want)
+		}
+	}
+	unlock := func(locker distributedLocker) {
+		t.Helper()
+
+		if err := locker.unlock(ctx, key); err != nil {
+			t.Fatalf("unlock: %s", err)
+		}
+	}
+
+	// Lease held by another owner.
+	tryLock(locker1, time.Now().Add(time.Minute), true)
+	tryLock(locker2, time.Now().Add(time.Minute), false)
+
+	// Renewal by the owner.
+	tryLock(locker1, time.Now().Add(time.Minute), true)
+
+	// Unlock by a non-owner has no effect.
+	unlock(locker2)
+	tryLock(locker2, time.Now().Add(time.Minute), false)
+
+	// Unlock by the owner.
+	unlock(locker1)
+	tryLock(locker2, time.Now().Add(time.Minute), true)
+	unlock(locker2)
+
+	// Expired leases can be taken over.
+	tryLock(locker1, time.Now().Add(-time.Minute), true)
+	tryLock(locker2, time.Now().Add(time.Minute), true)
+	tryLock(locker1, time.Now().Add(time.Minute), false)
+
+	// Unlocking a lease that has been taken over has no effect.
+	unlock(locker1)
+	tryLock(locker1, time.Now().Add(time.Minute), false)
+	unlock(locker2)
+}
@@ -0,0 +1,83 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"slices"
+	"sync"
+)
+
+// MemoizeDataSourcesConfig configures memoization of data source reads.
+type MemoizeDataSourcesConfig struct {
+	Exclude []string // Data source type names whose reads are never memoized.
+}
+
+// dataSourceMemoizer memoizes data source reads for the lifetime of a provider instance.
+// Reads are keyed on data source type name and canonicalized configuration.
+type dataSourceMemoizer struct {
+	exclude []string
+
+	lock    sync.Mutex
+	entries map[string]*memoizedRead
+}
+
+// memoizedRead is the memoized result of a single data source read.
+// Its lock is held while the data source is read so that concurrent identical reads wait for the result.
+type memoizedRead struct {
+	lock   sync.Mutex
+	ok     bool
+	result any
+}
+
+func newDataSourceMemoizer(c *MemoizeDataSourcesConfig) *dataSourceMemoizer {
+	return &dataSourceMemoizer{
+		exclude: c.Exclude,
+		entries: make(map[string]*memoizedRead),
+	}
+}
+
+// get returns the entry for the specified key, creating it if necessary.
+func (m *dataSourceMemoizer) get(key string) *memoizedRead {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+
+	entry, ok := m.entries[key]
+	if !ok {
+		entry = &memoizedRead{}
+		m.entries[key] = entry
+	}
+
+	return entry
+}
+
+// MemoizeDataSourceRead calls read unless a result has already been memoized for the specified data source type and configuration,
+// in which case the memoized result and true are returned.
+// read returns the result of the read and whether it can be memoized, e.g. false if the read failed.
+// If data source memoization is not configured, or is not configured for the data source type, read is always called.
+func (c *AWSClient) MemoizeDataSourceRead(typeName string, config []byte, read func() (any, bool)) (any, bool) {
+	m := c.dataSourceMemoizer
+
+	if m == nil || slices.Contains(m.exclude, typeName) {
+		read()
+
+		return nil, false
+	}
+
+	entry := m.get(typeName + "\x00" + string(config))
+
+	entry.lock.Lock()
+	defer entry.lock.Unlock()
+
+	if entry.ok {
+		return entry.result, true
+	}
+
+	entry.result, entry.ok = read()
+
+	if !entry.ok {
+		entry.result = nil
+	}
+
+	return nil, false
+}
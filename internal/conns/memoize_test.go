@@ -0,0 +1,125 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package conns
+
+import (
+	"sync"
+	"sync/atomic"
+	"testing"
+)
+
+func TestMemoizeDataSourceRead(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		config    *MemoizeDataSourcesConfig
+		typeName  string
+		readOK    bool
+		wantReads int32
+	}{
+		"not configured": {
+			typeName:  "aws_region",
+			readOK:    true,
+			wantReads: 3,
+		},
+		"memoized": {
+			config:    &MemoizeDataSourcesConfig{},
+			typeName:  "aws_region",
+			readOK:    true,
+			wantReads: 1,
+		},
+		"excluded": {
+			config:    &MemoizeDataSourcesConfig{Exclude: []string{"aws_ami"}},
+			typeName:  "aws_ami",
+			readOK:    true,
+			wantReads: 3,
+		},
+		"read failed": {
+			config:    &MemoizeDataSourcesConfig{},
+			typeName:  "aws_region",
+			readOK:    false,
+			wantReads: 3,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			client := &AWSClient{}
+			if testCase.config != nil {
+				client.dataSourceMemoizer = newDataSourceMemoizer(testCase.config)
+			}
+
+			var reads int32
+			for i := 0; i < 3; i++ {
+				v, ok := client.MemoizeDataSourceRead(testCase.typeName, []byte(`{"name":"test"}`), func() (any, bool) {
+					atomic.AddInt32(&reads, 1)
+					return "result", testCase.readOK
+				})
+
+				if ok && v != "result" {
+					t.Errorf("memoized result = %v, want %v", v, "result")
+				}
+			}
+
+			if got, want := reads, testCase.wantReads; got != want {
+				t.Errorf("reads = %d, want %d", got, want)
+			}
+		})
+	}
+}
+
+func TestMemoizeDataSourceReadKeys(t *testing.T) {
+	t.Parallel()
+
+	client := &AWSClient{
+		dataSourceMemoizer: newDataSourceMemoizer(&MemoizeDataSourcesConfig{}),
+	}
+
+	var reads int32
+	read := func() (any, bool) {
+		atomic.AddInt32(&reads, 1)
+		return nil, true
+	}
+
+	client.MemoizeDataSourceRead("aws_vpc", []byte(`{"id":"vpc-1"}`), read)
+	client.MemoizeDataSourceRead("aws_vpc", []byte(`{"id":"vpc-2"}`), read)
+	client.MemoizeDataSourceRead("aws_subnet", []byte(`{"id":"vpc-1"}`), read)
+	client.MemoizeDataSourceRead("aws_vpc", []byte(`{"id":"vpc-1"}`), read)
+
+	if got, want := reads, int32(3); got != want {
+		t.Errorf("reads = %d, want %d", got, want)
+	}
+}
+
+func TestMemoizeDataSourceReadConcurrent(t *testing.T) {
+	t.Parallel()
+
+	client := &AWSClient{
+		dataSourceMemoizer: newDataSourceMemoizer(&MemoizeDataSourcesConfig{}),
+	}
+
+	var reads int32
+	var wg sync.WaitGroup
+	for i := 0; i < 10; i++ {
+		wg.Add(1)
+
+		go func() {
+			defer wg.Done()
+
+			client.MemoizeDataSourceRead("aws_caller_identity", []byte(`{}`), func() (any, bool) {
+				atomic.AddInt32(&reads, 1)
+				return nil, true
+			})
+		}()
+	}
+	wg.Wait()
+
+	if got, want := reads, int32(1); got != want {
+		t.Errorf("reads = %d, want %d", got, want)
+	}
+}
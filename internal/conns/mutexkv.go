@@ -4,7 +4,10 @@
 package conns
 
 import (
+	"context"
+	"log"
 	"sync"
+	"time"
 )
 
 // GlobalMutexKV is a global MutexKV for use within this plugin.
@@ -13,19 +16,35 @@ var GlobalMutexKV = newMutexKV()
 // mutexKV is a simple key/value store for arbitrary mutexes. It can be used to
 // serialize changes across arbitrary collaborators that share knowledge of the
 // keys they must serialize on.
+// If a distributed locker is configured, a lease on the key is also taken so that
+// changes are serialized across provider processes.
 type mutexKV struct {
 	lock  sync.Mutex
 	store map[string]*sync.Mutex
+
+	locker        distributedLocker
+	leaseDuration time.Duration
+	pollInterval  time.Duration
+	leases        map[string]*lease
+}
+
+// lease is a held distributed lock lease that is renewed in the background.
+type lease struct {
+	locker distributedLocker
+	cancel context.CancelFunc
+	done   chan struct{}
 }
 
 // Locks the mutex for the given key. Caller is responsible for calling Unlock
 // for the same key
 func (m *mutexKV) Lock(key string) {
 	m.get(key).Lock()
+	m.acquire(key)
 }
 
 // Unlock the mutex for the given key. Caller must have called Lock for the same key first
 func (m *mutexKV) Unlock(key string) {
+	m.release(key)
 	m.get(key).Unlock()
 }
 
@@ -41,9 +60,106 @@ func (m *mutexKV) get(key string) *sync.Mutex {
 	return mutex
 }
 
+// setDistributedLocker configures the distributed locker used for subsequent locks.
+func (m *mutexKV) setDistributedLocker(locker distributedLocker, leaseDuration time.Duration) {
+	m.lock.Lock()
+	defer m.lock.Unlock()
+	m.locker = locker
+	m.leaseDuration = leaseDuration
+}
+
+// acquire blocks until a distributed lease on the given key is held.
+// The caller must hold the key's mutex.
+// If the lease cannot be acquired because of an error, only the in-process mutex is held.
+func (m *mutexKV) acquire(key string) {
+	m.lock.Lock()
+	locker, leaseDuration, pollInterval := m.locker, m.leaseDuration, m.pollInterval
+	m.lock.Unlock()
+
+	if locker == nil {
+		return
+	}
+
+	ctx := context.Background()
+
+	for {
+		ok, err := locker.tryLock(ctx, key, time.Now().Add(leaseDuration))
+
+		if err != nil {
+			log.Printf("[ERROR] acquiring distributed lock (%s), continuing with in-process lock only: %s", key, err)
+			return
+		}
+
+		if ok {
+			break
+		}
+
+		log.Printf("[DEBUG] Waiting for distributed lock (%s)", key)
+		time.Sleep(pollInterval)
+	}
+
+	ctx, cancel := context.WithCancel(ctx)
+	l := &lease{
+		locker: locker,
+		cancel: cancel,
+		done:   make(chan struct{}),
+	}
+
+	go func() {
+		defer close(l.done)
+
+		ticker := time.NewTicker(leaseDuration / 3) //nolint:mnd // Renew well before the lease expires.
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+				ok, err := locker.tryLock(ctx, key, time.Now().Add(leaseDuration))
+
+				if ctx.Err() != nil {
+					return
+				}
+
+				if err != nil {
+					log.Printf("[WARN] renewing distributed lock (%s): %s", key, err)
+				} else if !ok {
+					log.Printf("[WARN] distributed lock (%s) lease lost to another owner", key)
+				}
+			}
+		}
+	}()
+
+	m.lock.Lock()
+	m.leases[key] = l
+	m.lock.Unlock()
+}
+
+// release releases any distributed lease held on the given key.
+func (m *mutexKV) release(key string) {
+	m.lock.Lock()
+	l, ok := m.leases[key]
+	delete(m.leases, key)
+	m.lock.Unlock()
+
+	if !ok {
+		return
+	}
+
+	l.cancel()
+	<-l.done
+
+	if err := l.locker.unlock(context.Background(), key); err != nil {
+		log.Printf("[WARN] releasing distributed lock (%s): %s", key, err)
+	}
+}
+
 // Returns a properly initialized MutexKV
 func newMutexKV() *mutexKV {
 	return &mutexKV{
-		store: make(map[string]*sync.Mutex),
+		store:        make(map[string]*sync.Mutex),
+		pollInterval: defaultDistributedLockPollInterval,
+		leases:       make(map[string]*lease),
 	}
 }
@@ -0,0 +1,150 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package exclusive
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"slices"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+const (
+	defaultBatchSize = 1
+	defaultTimeout   = 2 * time.Minute
+)
+
+// Collection describes how to exclusively manage a parent's collection of children,
+// e.g. the managed policies attached to an IAM role.
+// The children are identified by comparable values, typically names or ARNs.
+type Collection[T comparable] struct {
+	// Description describes the collection in error messages, e.g. "IAM Role (example) inline policies".
+	Description string
+	// List returns the parent's current children.
+	List func(context.Context) ([]T, error)
+	// Add adds a batch of children to the parent.
+	// If nil, configured children that don't exist are left to other resources to create.
+	Add func(context.Context, []T) error
+	// Remove removes a batch of children from the parent.
+	Remove func(context.Context, []T) error
+	// BatchSize is the maximum number of children passed to a single Add or Remove call.
+	// Defaults to 1.
+	BatchSize int
+	// Retryable decides whether a failed Add or Remove call is retried.
+	// If nil, calls are not retried.
+	Retryable tfresource.Retryable
+	// Timeout bounds the retries of each Add or Remove call.
+	// Defaults to 2 minutes.
+	Timeout time.Duration
+}
+
+// Changes are the children that must be added to and removed from a collection to make it match the desired set.
+type Changes[T comparable] struct {
+	Add    []T
+	Remove []T
+}
+
+// IsEmpty returns whether there are no changes.
+func (c Changes[T]) IsEmpty() bool {
+	return len(c.Add) == 0 && len(c.Remove) == 0
+}
+
+// Diff returns the changes required to make the `have` children match the `want` children.
+func Diff[T comparable](have, want []T) Changes[T] {
+	add, remove, _ := flex.DiffSlices(have, slices.Clone(want), func(v1, v2 T) bool {
+		return v1 == v2
+	})
+
+	return Changes[T]{
+		Add:    add,
+		Remove: remove,
+	}
+}
+
+// Drift returns the changes made outside of Terraform, i.e. those required to make the children in state match the parent's current children.
+func (c *Collection[T]) Drift(ctx context.Context, state []T) (Changes[T], []T, error) {
+	have, err := c.List(ctx)
+
+	if err != nil {
+		return Changes[T]{}, nil, fmt.Errorf("reading %s: %w", c.Description, err)
+	}
+
+	return Diff(state, have), have, nil
+}
+
+// NewDriftWarningDiagnostic returns a warning diagnostic describing changes made to a collection outside of Terraform.
+func NewDriftWarningDiagnostic[T comparable](description string, drift Changes[T]) diag.Diagnostic {
+	return diag.NewWarningDiagnostic(
+		fmt.Sprintf("%s changed outside of Terraform", description),
+		fmt.Sprintf("Added outside of Terraform: %v. Removed outside of Terraform: %v.", drift.Add, drift.Remove),
+	)
+}
+
+// Sync makes the parent's children exactly match the `want` children.
+// Children are removed before any are added, so that limits on the number of children aren't exceeded.
+func (c *Collection[T]) Sync(ctx context.Context, want []T) error {
+	have, err := c.List(ctx)
+
+	if err != nil {
+		return fmt.Errorf("reading %s: %w", c.Description, err)
+	}
+
+	changes := Diff(have, want)
+
+	if err := c.apply(ctx, changes.Remove, c.Remove); err != nil {
+		return fmt.Errorf("removing %s: %w", c.Description, err)
+	}
+
+	if c.Add == nil {
+		return nil
+	}
+
+	if err := c.apply(ctx, changes.Add, c.Add); err != nil {
+		return fmt.Errorf("adding %s: %w", c.Description, err)
+	}
+
+	return nil
+}
+
+func (c *Collection[T]) apply(ctx context.Context, children []T, f func(context.Context, []T) error) error {
+	if len(children) == 0 {
+		return nil
+	}
+
+	batchSize := c.BatchSize
+	if batchSize <= 0 {
+		batchSize = defaultBatchSize
+	}
+
+	timeout := c.Timeout
+	if timeout <= 0 {
+		timeout = defaultTimeout
+	}
+
+	var errs []error
+
+	for _, batch := range tfslices.Chunks(children, batchSize) {
+		var err error
+
+		if c.Retryable == nil {
+			err = f(ctx, batch)
+		} else {
+			_, err = tfresource.RetryWhen(ctx, timeout, func() (interface{}, error) {
+				return nil, f(ctx, batch)
+			}, c.Retryable)
+		}
+
+		if err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package exclusive_test
+
+import (
+	"context"
+	"errors"
+	"slices"
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/google/go-cmp/cmp/cmpopts"
+	"github.com/hashicorp/terraform-provider-aws/internal/exclusive"
+)
+
+func TestDiff(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		have, want []string
+		expected   exclusive.Changes[string]
+	}
+	tests := map[string]testCase{
+		"empty": {},
+		"add": {
+			want:     []string{"a", "b"},
+			expected: exclusive.Changes[string]{Add: []string{"a", "b"}},
+		},
+		"remove": {
+			have:     []string{"a", "b"},
+			expected: exclusive.Changes[string]{Remove: []string{"a", "b"}},
+		},
+		"unchanged": {
+			have: []string{"a", "b"},
+			want: []string{"b", "a"},
+		},
+		"add and remove": {
+			have:     []string{"a", "b", "c"},
+			want:     []string{"b", "d"},
+			expected: exclusive.Changes[string]{Add: []string{"d"}, Remove: []string{"a", "c"}},
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			want := slices.Clone(test.want)
+			got := exclusive.Diff(test.have, test.want)
+
+			if diff := cmp.Diff(got, test.expected, cmpopts.EquateEmpty()); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+			if got, want := got.IsEmpty(), len(test.expected.Add) == 0 && len(test.expected.Remove) == 0; got != want {
+				t.Errorf("IsEmpty() = %t, want %t", got, want)
+			}
+			if !slices.Equal(want, test.want) {
+				t.Errorf("input modified: %v, want %v", test.want, want)
+			}
+		})
+	}
+}
+
+type fakeParent struct {
+	children []string
+	calls    [][]string
+	failures int
+}
+
+func (p *fakeParent) collection() *exclusive.Collection[string] {
+	return &exclusive.Collection[string]{
+		Description: "test children",
+		List: func(context.Context) ([]string, error) {
+			return slices.Clone(p.children), nil
+		},
+		Add: func(_ context.Context, children []string) error {
+			p.calls = append(p.calls, children)
+			if p.failures > 0 {
+				p.failures--
+				return errRetryable
+			}
+			p.children = append(p.children, children...)
+			return nil
+		},
+		Remove: func(_ context.Context, children []string) error {
+			p.calls = append(p.calls, children)
+			p.children = slices.DeleteFunc(p.children, func(v string) bool {
+				return slices.Contains(children, v)
+			})
+			return nil
+		},
+	}
+}
+
+var errRetryable = errors.New("retryable")
+
+func TestCollectionSync(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	parent := &fakeParent{children: []string{"a", "b", "c"}}
+	c := parent.collection()
+	c.BatchSize = 2
+
+	if err := c.Sync(ctx, []string{"c", "d", "e", "f"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(parent.children, []string{"c", "d", "e", "f"}); diff != "" {
+		t.Errorf("unexpected children diff (+wanted, -got): %s", diff)
+	}
+	if diff := cmp.Diff(parent.calls, [][]string{{"a", "b"}, {"d", "e"}, {"f"}}); diff != "" {
+		t.Errorf("unexpected calls diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestCollectionSync_noAdd(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	parent := &fakeParent{children: []string{"a", "b"}}
+	c := parent.collection()
+	c.Add = nil
+
+	if err := c.Sync(ctx, []string{"b", "c"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(parent.children, []string{"b"}); diff != "" {
+		t.Errorf("unexpected children diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestCollectionSync_retry(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	parent := &fakeParent{failures: 1}
+	c := parent.collection()
+	c.Retryable = func(err error) (bool, error) {
+		return errors.Is(err, errRetryable), err
+	}
+	c.Timeout = 30 * time.Second
+
+	if err := c.Sync(ctx, []string{"a"}); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(parent.children, []string{"a"}); diff != "" {
+		t.Errorf("unexpected children diff (+wanted, -got): %s", diff)
+	}
+	if got, want := len(parent.calls), 2; got != want {
+		t.Errorf("calls = %d, want %d", got, want)
+	}
+}
+
+func TestCollectionSync_error(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	parent := &fakeParent{failures: 1}
+
+	if err := parent.collection().Sync(ctx, []string{"a"}); !errors.Is(err, errRetryable) {
+		t.Fatalf("expected error %q, got: %v", errRetryable, err)
+	}
+}
+
+func TestCollectionDrift(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	parent := &fakeParent{children: []string{"a", "c"}}
+
+	drift, have, err := parent.collection().Drift(ctx, []string{"a", "b"})
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	if diff := cmp.Diff(drift, exclusive.Changes[string]{Add: []string{"c"}, Remove: []string{"b"}}); diff != "" {
+		t.Errorf("unexpected drift diff (+wanted, -got): %s", diff)
+	}
+	if diff := cmp.Diff(have, []string{"a", "c"}); diff != "" {
+		t.Errorf("unexpected children diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestNewDriftWarningDiagnostic(t *testing.T) {
+	t.Parallel()
+
+	d := exclusive.NewDriftWarningDiagnostic("test children", exclusive.Changes[string]{Add: []string{"c"}, Remove: []string{"b"}})
+
+	if got, want := d.Summary(), "test children changed outside of Terraform"; got != want {
+		t.Errorf("Summary() = %q, want %q", got, want)
+	}
+	if got, want := d.Detail(), "Added outside of Terraform: [c]. Removed outside of Terraform: [b]."; got != want {
+		t.Errorf("Detail() = %q, want %q", got, want)
+	}
+}
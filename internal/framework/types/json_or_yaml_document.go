@@ -0,0 +1,231 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+var (
+	_ basetypes.StringTypable = (*jsonOrYAMLDocumentType)(nil)
+)
+
+type jsonOrYAMLDocumentType struct {
+	basetypes.StringType
+}
+
+var (
+	JSONOrYAMLDocumentType = jsonOrYAMLDocumentType{}
+)
+
+func (t jsonOrYAMLDocumentType) Equal(o attr.Type) bool {
+	other, ok := o.(jsonOrYAMLDocumentType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t jsonOrYAMLDocumentType) String() string {
+	return "JSONOrYAMLDocumentType"
+}
+
+func (t jsonOrYAMLDocumentType) ValueFromString(_ context.Context, in types.String) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return JSONOrYAMLDocumentNull(), diags
+	}
+	if in.IsUnknown() {
+		return JSONOrYAMLDocumentUnknown(), diags
+	}
+
+	return JSONOrYAMLDocument{StringValue: in}, diags
+}
+
+func (t jsonOrYAMLDocumentType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t jsonOrYAMLDocumentType) ValueType(context.Context) attr.Value {
+	return JSONOrYAMLDocument{}
+}
+
+var (
+	_ basetypes.StringValuable                   = (*JSONOrYAMLDocument)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*JSONOrYAMLDocument)(nil)
+	_ xattr.ValidateableAttribute                = (*JSONOrYAMLDocument)(nil)
+)
+
+func JSONOrYAMLDocumentNull() JSONOrYAMLDocument {
+	return JSONOrYAMLDocument{StringValue: basetypes.NewStringNull()}
+}
+
+func JSONOrYAMLDocumentUnknown() JSONOrYAMLDocument {
+	return JSONOrYAMLDocument{StringValue: basetypes.NewStringUnknown()}
+}
+
+func JSONOrYAMLDocumentValue(value string) JSONOrYAMLDocument {
+	return JSONOrYAMLDocument{StringValue: basetypes.NewStringValue(value)}
+}
+
+// JSONOrYAMLDocument is a document, e.g. a CloudFormation template, in either JSON or YAML format.
+// A value starting with `{` is treated as JSON, otherwise as YAML.
+// Values in different formats are never semantically equal.
+// See verify.SuppressEquivalentJSONOrYAMLDiffs.
+type JSONOrYAMLDocument struct {
+	basetypes.StringValue
+}
+
+func (v JSONOrYAMLDocument) Equal(o attr.Value) bool {
+	other, ok := o.(JSONOrYAMLDocument)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v JSONOrYAMLDocument) Type(context.Context) attr.Type {
+	return JSONOrYAMLDocumentType
+}
+
+// IsJSON returns whether the value is in JSON format.
+func (v JSONOrYAMLDocument) IsJSON() bool {
+	return looksLikeJSONString(v.ValueString())
+}
+
+// Normalized returns the value's canonical JSON or YAML representation.
+func (v JSONOrYAMLDocument) Normalized() (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.IsUnknown() {
+		return "", diags
+	}
+
+	s, err := normalizeJSONOrYAMLString(v.ValueString())
+	if err != nil {
+		diags.AddError(
+			"JSON or YAML Normalization Error",
+			"An unexpected error occurred while normalizing a JSON or YAML string. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return "", diags
+	}
+
+	return s, diags
+}
+
+func (v JSONOrYAMLDocument) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(JSONOrYAMLDocument)
+
+	if !ok {
+		return false, diags
+	}
+
+	if v.IsJSON() != newValue.IsJSON() {
+		return false, diags
+	}
+
+	s1, err := normalizeJSONOrYAMLString(v.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	s2, err := normalizeJSONOrYAMLString(newValue.ValueString())
+	if err != nil {
+		return false, diags
+	}
+
+	return s1 == s2, diags
+}
+
+func (v JSONOrYAMLDocument) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if v.IsJSON() {
+		if !json.Valid([]byte(v.ValueString())) {
+			resp.Diagnostics.AddAttributeError(
+				req.Path,
+				"Invalid JSON Document Value",
+				"The provided value is not valid JSON string format (RFC 7159).\n\n"+
+					"Path: "+req.Path.String()+"\n"+
+					"Value: "+v.ValueString(),
+			)
+		}
+
+		return
+	}
+
+	if _, err := normalizeYAMLString(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid YAML Document Value",
+			"The provided value is not a valid YAML document.\n\n"+
+				"Path: "+req.Path.String()+"\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+func looksLikeJSONString(s string) bool {
+	return strings.HasPrefix(strings.TrimSpace(s), "{")
+}
+
+// normalizeJSONOrYAMLString returns a canonical representation of the JSON or YAML document in the specified string.
+// See verify.NormalizeJSONOrYAMLString, which can't be called because of import cycles.
+func normalizeJSONOrYAMLString(s string) (string, error) {
+	if looksLikeJSONString(s) {
+		var v any
+
+		if err := json.Unmarshal([]byte(s), &v); err != nil {
+			return "", err
+		}
+
+		b, err := json.Marshal(v)
+		if err != nil {
+			return "", err
+		}
+
+		return string(b), nil
+	}
+
+	return normalizeYAMLString(s)
+}
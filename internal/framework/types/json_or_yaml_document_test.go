@@ -0,0 +1,113 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestJSONOrYAMLDocumentValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         fwtypes.JSONOrYAMLDocument
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: fwtypes.JSONOrYAMLDocumentUnknown(),
+		},
+		"null": {
+			val: fwtypes.JSONOrYAMLDocumentNull(),
+		},
+		"valid JSON": {
+			val: fwtypes.JSONOrYAMLDocumentValue(`{"Key1": "Value", "Key2": [1, 2, 3]}`),
+		},
+		"invalid JSON": {
+			val:         fwtypes.JSONOrYAMLDocumentValue(`{"Key1": "Value"`),
+			expectError: true,
+		},
+		"valid YAML": {
+			val: fwtypes.JSONOrYAMLDocumentValue("Key1: Value\nKey2: [1, 2, 3]\n"),
+		},
+		"invalid YAML": {
+			val:         fwtypes.JSONOrYAMLDocumentValue("Key1: [Value\n"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			req := xattr.ValidateAttributeRequest{}
+			resp := xattr.ValidateAttributeResponse{}
+
+			test.val.ValidateAttribute(ctx, req, &resp)
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("resp.Diagnostics.HasError() = %t, want = %t", resp.Diagnostics.HasError(), test.expectError)
+			}
+		})
+	}
+}
+
+func TestJSONOrYAMLDocumentStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val1, val2 fwtypes.JSONOrYAMLDocument
+		equals     bool
+	}
+	tests := map[string]testCase{
+		"JSON equals": {
+			val1: fwtypes.JSONOrYAMLDocumentValue(`{"Key1": "Value", "Key2": [1, 2, 3]}`),
+			val2: fwtypes.JSONOrYAMLDocumentValue(`
+{
+  "Key2": [1, 2, 3],
+  "Key1": "Value"
+}
+`),
+			equals: true,
+		},
+		"JSON not equals": {
+			val1: fwtypes.JSONOrYAMLDocumentValue(`{"Key1": "Value1"}`),
+			val2: fwtypes.JSONOrYAMLDocumentValue(`{"Key1": "Value2"}`),
+		},
+		"YAML equals": {
+			val1:   fwtypes.JSONOrYAMLDocumentValue("Key1: Value\nKey2: [1, 2, 3]\n"),
+			val2:   fwtypes.JSONOrYAMLDocumentValue("Key2:\n  - 1\n  - 2\n  - 3\nKey1: Value # Comment.\n"),
+			equals: true,
+		},
+		"YAML not equals": {
+			val1: fwtypes.JSONOrYAMLDocumentValue("Key1: Value1\n"),
+			val2: fwtypes.JSONOrYAMLDocumentValue("Key1: Value2\n"),
+		},
+		"JSON and YAML": {
+			val1: fwtypes.JSONOrYAMLDocumentValue(`{"Key1": "Value"}`),
+			val2: fwtypes.JSONOrYAMLDocumentValue("Key1: Value\n"),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			equals, _ := test.val1.StringSemanticEquals(ctx, test.val2)
+
+			if got, want := equals, test.equals; got != want {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", test.val1, test.val2, got, want)
+			}
+		})
+	}
+}
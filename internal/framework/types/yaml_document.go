@@ -0,0 +1,260 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"gopkg.in/yaml.v3"
+)
+
+var (
+	_ basetypes.StringTypable = (*yamlDocumentType)(nil)
+)
+
+type yamlDocumentType struct {
+	basetypes.StringType
+}
+
+var (
+	YAMLDocumentType = yamlDocumentType{}
+)
+
+func (t yamlDocumentType) Equal(o attr.Type) bool {
+	other, ok := o.(yamlDocumentType)
+
+	if !ok {
+		return false
+	}
+
+	return t.StringType.Equal(other.StringType)
+}
+
+func (t yamlDocumentType) String() string {
+	return "YAMLDocumentType"
+}
+
+func (t yamlDocumentType) ValueFromString(_ context.Context, in types.String) (basetypes.StringValuable, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if in.IsNull() {
+		return YAMLDocumentNull(), diags
+	}
+	if in.IsUnknown() {
+		return YAMLDocumentUnknown(), diags
+	}
+
+	return YAMLDocument{StringValue: in}, diags
+}
+
+func (t yamlDocumentType) ValueFromTerraform(ctx context.Context, in tftypes.Value) (attr.Value, error) {
+	attrValue, err := t.StringType.ValueFromTerraform(ctx, in)
+
+	if err != nil {
+		return nil, err
+	}
+
+	stringValue, ok := attrValue.(basetypes.StringValue)
+
+	if !ok {
+		return nil, fmt.Errorf("unexpected value type of %T", attrValue)
+	}
+
+	stringValuable, diags := t.ValueFromString(ctx, stringValue)
+
+	if diags.HasError() {
+		return nil, fmt.Errorf("unexpected error converting StringValue to StringValuable: %v", diags)
+	}
+
+	return stringValuable, nil
+}
+
+func (t yamlDocumentType) ValueType(context.Context) attr.Value {
+	return YAMLDocument{}
+}
+
+var (
+	_ basetypes.StringValuable                   = (*YAMLDocument)(nil)
+	_ basetypes.StringValuableWithSemanticEquals = (*YAMLDocument)(nil)
+	_ xattr.ValidateableAttribute                = (*YAMLDocument)(nil)
+)
+
+func YAMLDocumentNull() YAMLDocument {
+	return YAMLDocument{StringValue: basetypes.NewStringNull()}
+}
+
+func YAMLDocumentUnknown() YAMLDocument {
+	return YAMLDocument{StringValue: basetypes.NewStringUnknown()}
+}
+
+func YAMLDocumentValue(value string) YAMLDocument {
+	return YAMLDocument{StringValue: basetypes.NewStringValue(value)}
+}
+
+// YAMLDocument is a YAML document whose semantic equality ignores formatting, comments and mapping key order.
+type YAMLDocument struct {
+	basetypes.StringValue
+}
+
+func (v YAMLDocument) Equal(o attr.Value) bool {
+	other, ok := o.(YAMLDocument)
+
+	if !ok {
+		return false
+	}
+
+	return v.StringValue.Equal(other.StringValue)
+}
+
+func (v YAMLDocument) Type(context.Context) attr.Type {
+	return YAMLDocumentType
+}
+
+// Normalized returns the value's canonical YAML representation.
+func (v YAMLDocument) Normalized() (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if v.IsNull() || v.IsUnknown() {
+		return "", diags
+	}
+
+	s, err := normalizeYAMLString(v.ValueString())
+	if err != nil {
+		diags.AddError(
+			"YAML Normalization Error",
+			"An unexpected error occurred while normalizing a YAML string. "+
+				"Please report this to the provider developers.\n\n"+
+				"Error: "+err.Error(),
+		)
+		return "", diags
+	}
+
+	return s, diags
+}
+
+func (v YAMLDocument) StringSemanticEquals(_ context.Context, newValuable basetypes.StringValuable) (bool, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	newValue, ok := newValuable.(YAMLDocument)
+
+	if !ok {
+		return false, diags
+	}
+
+	return yamlStringsEquivalent(v.ValueString(), newValue.ValueString()), diags
+}
+
+func (v YAMLDocument) ValidateAttribute(ctx context.Context, req xattr.ValidateAttributeRequest, resp *xattr.ValidateAttributeResponse) {
+	if v.IsNull() || v.IsUnknown() {
+		return
+	}
+
+	if _, err := normalizeYAMLString(v.ValueString()); err != nil {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Invalid YAML Document Value",
+			"The provided value is not a valid YAML document.\n\n"+
+				"Path: "+req.Path.String()+"\n"+
+				"Error: "+err.Error(),
+		)
+	}
+}
+
+func yamlStringsEquivalent(s1, s2 string) bool {
+	n1, err := normalizeYAMLString(s1)
+	if err != nil {
+		return false
+	}
+
+	n2, err := normalizeYAMLString(s2)
+	if err != nil {
+		return false
+	}
+
+	return n1 == n2
+}
+
+// normalizeYAMLString returns a canonical representation of the YAML document(s) in the specified string.
+// Comments, scalar and collection styles and indentation are normalized and mapping keys are sorted.
+// Explicit tags, e.g. CloudFormation's `!Ref`, anchors and aliases are preserved.
+func normalizeYAMLString(s string) (string, error) {
+	decoder := yaml.NewDecoder(strings.NewReader(strings.ReplaceAll(s, "\r\n", "\n")))
+
+	var buf bytes.Buffer
+	encoder := yaml.NewEncoder(&buf)
+	encoder.SetIndent(2)
+
+	n := 0
+	for ; ; n++ {
+		var node yaml.Node
+
+		if err := decoder.Decode(&node); errors.Is(err, io.EOF) {
+			break
+		} else if err != nil {
+			return "", err
+		}
+
+		canonicalizeYAMLNode(&node)
+
+		if err := encoder.Encode(&node); err != nil {
+			return "", err
+		}
+	}
+
+	// An empty document.
+	if n == 0 {
+		return "", nil
+	}
+
+	if err := encoder.Close(); err != nil {
+		return "", err
+	}
+
+	return buf.String(), nil
+}
+
+func canonicalizeYAMLNode(node *yaml.Node) {
+	node.HeadComment = ""
+	node.LineComment = ""
+	node.FootComment = ""
+	// Only retain explicit tags. The encoder chooses a style that preserves each scalar's resolved tag.
+	node.Style &= yaml.TaggedStyle
+
+	for _, v := range node.Content {
+		canonicalizeYAMLNode(v)
+	}
+
+	if node.Kind == yaml.MappingNode {
+		type pair struct {
+			key, value *yaml.Node
+		}
+
+		n := len(node.Content) / 2
+		pairs := make([]pair, n)
+		for i := 0; i < n; i++ {
+			pairs[i] = pair{key: node.Content[2*i], value: node.Content[2*i+1]}
+		}
+
+		sort.SliceStable(pairs, func(i, j int) bool {
+			return pairs[i].key.Value < pairs[j].key.Value
+		})
+
+		for i, p := range pairs {
+			node.Content[2*i] = p.key
+			node.Content[2*i+1] = p.value
+		}
+	}
+}
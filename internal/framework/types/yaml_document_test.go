@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr/xattr"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+)
+
+func TestYAMLDocumentValidateAttribute(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val         fwtypes.YAMLDocument
+		expectError bool
+	}
+	tests := map[string]testCase{
+		"unknown": {
+			val: fwtypes.YAMLDocumentUnknown(),
+		},
+		"null": {
+			val: fwtypes.YAMLDocumentNull(),
+		},
+		"valid": {
+			val: fwtypes.YAMLDocumentValue("key1: value\nkey2: [1, 2, 3]\n"),
+		},
+		"valid with tags": {
+			val: fwtypes.YAMLDocumentValue("Value: !Ref Bucket\n"),
+		},
+		"invalid": {
+			val:         fwtypes.YAMLDocumentValue("key1: [value\n"),
+			expectError: true,
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			req := xattr.ValidateAttributeRequest{}
+			resp := xattr.ValidateAttributeResponse{}
+
+			test.val.ValidateAttribute(ctx, req, &resp)
+			if resp.Diagnostics.HasError() != test.expectError {
+				t.Errorf("resp.Diagnostics.HasError() = %t, want = %t", resp.Diagnostics.HasError(), test.expectError)
+			}
+		})
+	}
+}
+
+func TestYAMLDocumentStringSemanticEquals(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val1, val2 fwtypes.YAMLDocument
+		equals     bool
+	}
+	tests := map[string]testCase{
+		"both empty": {
+			val1:   fwtypes.YAMLDocumentValue(``),
+			val2:   fwtypes.YAMLDocumentValue("\n"),
+			equals: true,
+		},
+		"key order, whitespace and comments": {
+			val1: fwtypes.YAMLDocumentValue(`
+Resources:
+  Bucket:
+    Type: AWS::S3::Bucket
+    Properties:
+      BucketName: example
+      Tags: [{Key: Name, Value: example}]
+`),
+			val2: fwtypes.YAMLDocumentValue(`# A comment.
+Resources:
+    Bucket:
+        Properties:
+            Tags:
+              - Value: "example"
+                Key: Name
+            BucketName: 'example' # Another comment.
+        Type: AWS::S3::Bucket
+`),
+			equals: true,
+		},
+		"line endings": {
+			val1:   fwtypes.YAMLDocumentValue("key1: value1\r\nkey2: value2\r\n"),
+			val2:   fwtypes.YAMLDocumentValue("key1: value1\nkey2: value2\n"),
+			equals: true,
+		},
+		"not equals": {
+			val1: fwtypes.YAMLDocumentValue("key1: value1\n"),
+			val2: fwtypes.YAMLDocumentValue("key1: value2\n"),
+		},
+		"sequence order": {
+			val1: fwtypes.YAMLDocumentValue("key1: [1, 2]\n"),
+			val2: fwtypes.YAMLDocumentValue("key1: [2, 1]\n"),
+		},
+		"scalar tags": {
+			val1: fwtypes.YAMLDocumentValue("key1: 123\n"),
+			val2: fwtypes.YAMLDocumentValue("key1: \"123\"\n"),
+		},
+		"explicit tags": {
+			val1: fwtypes.YAMLDocumentValue("Value: !Ref Bucket\n"),
+			val2: fwtypes.YAMLDocumentValue("Value: Bucket\n"),
+		},
+		"invalid": {
+			val1: fwtypes.YAMLDocumentValue("key1: [value\n"),
+			val2: fwtypes.YAMLDocumentValue("key1: [value\n"),
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			ctx := context.Background()
+
+			equals, _ := test.val1.StringSemanticEquals(ctx, test.val2)
+
+			if got, want := equals, test.equals; got != want {
+				t.Errorf("StringSemanticEquals(%q, %q) = %v, want %v", test.val1, test.val2, got, want)
+			}
+		})
+	}
+}
+
+func TestYAMLDocumentNormalized(t *testing.T) {
+	t.Parallel()
+
+	type testCase struct {
+		val      fwtypes.YAMLDocument
+		expected string
+	}
+	tests := map[string]testCase{
+		"null": {
+			val: fwtypes.YAMLDocumentNull(),
+		},
+		"sorted": {
+			val:      fwtypes.YAMLDocumentValue("b: [1, 2] # Comment.\na: !Sub '${AWS::Region}'\n"),
+			expected: "a: !Sub ${AWS::Region}\nb:\n  - 1\n  - 2\n",
+		},
+	}
+
+	for name, test := range tests {
+		name, test := name, test
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, diags := test.val.Normalized()
+			if diags.HasError() {
+				t.Fatalf("unexpected error: %v", diags)
+			}
+
+			if got != test.expected {
+				t.Errorf("Normalized() = %q, want %q", got, test.expected)
+			}
+		})
+	}
+}
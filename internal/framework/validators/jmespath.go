@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package validators
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/helpers/validatordiag"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/jmespath/go-jmespath"
+)
+
+// jmesPathValidator validates that a string Attribute's value is a valid JMESPath expression.
+type jmesPathValidator struct{}
+
+// Description describes the validation in plain text formatting.
+func (validator jmesPathValidator) Description(_ context.Context) string {
+	return "value must be a valid JMESPath expression"
+}
+
+// MarkdownDescription describes the validation in Markdown formatting.
+func (validator jmesPathValidator) MarkdownDescription(ctx context.Context) string {
+	return validator.Description(ctx)
+}
+
+// Validate performs the validation.
+func (validator jmesPathValidator) ValidateString(ctx context.Context, request validator.StringRequest, response *validator.StringResponse) {
+	configValue := request.ConfigValue
+
+	if configValue.IsNull() || configValue.IsUnknown() {
+		return
+	}
+
+	// https://jmespath.org/specification.html.
+	if valueString := configValue.ValueString(); valueString != "" {
+		if _, err := jmespath.Compile(valueString); err != nil {
+			response.Diagnostics.Append(validatordiag.InvalidAttributeValueDiagnostic(
+				request.Path,
+				validator.Description(ctx),
+				valueString,
+			))
+			return
+		}
+	}
+}
+
+// JMESPath returns a string validator which ensures that any configured
+// attribute value:
+//
+//   - Is a string, which represents a valid JMESPath expression.
+//
+// Null (unconfigured) and unknown (known after apply) values are skipped.
+func JMESPath() validator.String {
+	return jmesPathValidator{}
+}
@@ -0,0 +1,34 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package framework
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/tfsdk"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+// GetWriteOnlyStringValue returns the value of the write-only string attribute at the specified path.
+// Write-only values, e.g. passwords, are read from configuration rather than plan during Create and Update
+// so that they need not be persisted.
+func GetWriteOnlyStringValue(ctx context.Context, config tfsdk.Config, path path.Path) (types.String, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	var v types.String
+
+	diags.Append(config.GetAttribute(ctx, path, &v)...)
+	if diags.HasError() {
+		return types.StringNull(), diags
+	}
+
+	if v.IsUnknown() {
+		diags.AddAttributeError(path, "Invalid Write-Only Value", "Write-only attribute values must be known during apply.")
+
+		return types.StringNull(), diags
+	}
+
+	return v, diags
+}
@@ -8,6 +8,7 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-framework/function"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 var _ function.Function = arnBuildFunction{}
@@ -29,7 +30,7 @@ func (f arnBuildFunction) Definition(ctx context.Context, req function.Definitio
 		Parameters: []function.Parameter{
 			function.StringParameter{
 				Name:                "partition",
-				MarkdownDescription: "Partition in which the resource is located",
+				MarkdownDescription: "Partition in which the resource is located. If empty, the partition is derived from the region",
 			},
 			function.StringParameter{
 				Name:                "service",
@@ -60,6 +61,11 @@ func (f arnBuildFunction) Run(ctx context.Context, req function.RunRequest, resp
 		return
 	}
 
+	// Allows ARNs in custom partitions to be built without hard-coding the partition.
+	if partition == "" && region != "" {
+		partition = names.PartitionForRegion(region)
+	}
+
 	result := arn.ARN{
 		Partition: partition,
 		Service:   service,
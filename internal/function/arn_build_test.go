@@ -31,9 +31,35 @@ func TestARNBuildFunction_known(t *testing.T) {
 	})
 }
 
+func TestARNBuildFunction_partitionFromRegion(t *testing.T) {
+	t.Parallel()
+
+	resource.UnitTest(t, resource.TestCase{
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(version.Must(version.NewVersion("1.8.0"))),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testARNBuildFunctionConfig_partitionFromRegion(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckOutput("test", "arn:aws-cn:s3:cn-north-1:444455556666:example"),
+				),
+			},
+		},
+	})
+}
+
 func testARNBuildFunctionConfig() string {
 	return `
 output "test" {
   value = provider::aws::arn_build("aws", "iam", "", "444455556666", "role/example")
 }`
 }
+
+func testARNBuildFunctionConfig_partitionFromRegion() string {
+	return `
+output "test" {
+  value = provider::aws::arn_build("", "s3", "cn-north-1", "444455556666", "example")
+}`
+}
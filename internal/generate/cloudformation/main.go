@@ -0,0 +1,564 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"flag"
+	"fmt"
+	"log"
+	"os"
+	"slices"
+	"sort"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+var (
+	schemaFile   = flag.String("schema", "", "path to the vendored CloudFormation resource schema JSON file")
+	resourceType = flag.String("resource", "", "Terraform resource type name, e.g. aws_iot_job_template")
+	resourceName = flag.String("name", "", "human-friendly resource name, e.g. \"Job Template\"")
+)
+
+func usage() {
+	fmt.Fprintf(os.Stderr, "Usage:\n")
+	fmt.Fprintf(os.Stderr, "\tmain.go -schema <file> -resource <aws_service_thing> -name <name>\n\n")
+	fmt.Fprintf(os.Stderr, "Flags:\n")
+	flag.PrintDefaults()
+}
+
+type TemplateData struct {
+	AttributeNames      []AttributeName
+	CFTypeName          string
+	FactoryName         string
+	Imports             []string
+	ProviderPackage     string
+	ReadOnlyProperties  []string
+	ResourceName        string
+	Schema              string
+	SchemaFile          string
+	TFTypeName          string
+	WriteOnlyProperties []string
+}
+
+type AttributeName struct {
+	Attribute string // Go expression for the Terraform attribute name.
+	Property  string
+}
+
+func main() {
+	g := common.NewGenerator()
+
+	log.SetFlags(0)
+	flag.Usage = usage
+	flag.Parse()
+
+	if *schemaFile == "" || *resourceType == "" || *resourceName == "" {
+		flag.Usage()
+		os.Exit(2)
+	}
+
+	servicePackage := os.Getenv("GOPACKAGE")
+	if servicePackage == "" {
+		g.Fatalf("GOPACKAGE environment variable not set")
+	}
+
+	resource, err := loadResource(*schemaFile)
+	if err != nil {
+		g.Fatalf("loading CloudFormation resource schema (%s): %s", *schemaFile, err)
+	}
+
+	e := newEmitter(resource, *resourceType)
+	schema, err := e.emitSchema()
+	if err != nil {
+		g.Fatalf("generating %s schema: %s", *resourceType, err)
+	}
+
+	goName := strings.ReplaceAll(*resourceName, " ", "")
+	templateData := TemplateData{
+		CFTypeName:          stringValue(resource.TypeName),
+		FactoryName:         fmt.Sprintf("new%sResource", goName),
+		Imports:             e.imports(),
+		ProviderPackage:     servicePackage,
+		ReadOnlyProperties:  pointers(resource.ReadOnlyProperties),
+		ResourceName:        *resourceName,
+		Schema:              schema,
+		SchemaFile:          *schemaFile,
+		TFTypeName:          *resourceType,
+		WriteOnlyProperties: pointers(resource.WriteOnlyProperties),
+	}
+
+	for _, k := range e.sortedAttributeNames() {
+		templateData.AttributeNames = append(templateData.AttributeNames, AttributeName{
+			Attribute: names.ConstOrQuote(k),
+			Property:  e.attributeNames[k],
+		})
+	}
+
+	filename := fmt.Sprintf("%s_gen.go", toSnakeCase(goName))
+
+	g.Infof("Generating internal/service/%s/%s", servicePackage, filename)
+
+	d := g.NewGoFileDestination(filename)
+
+	if err := d.WriteTemplate("cloudformation", resourceTemplateBody, templateData); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+
+	if err := d.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", filename, err)
+	}
+}
+
+//go:embed resource.tmpl
+var resourceTemplateBody string
+
+func loadResource(filename string) (*cfschema.Resource, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := cfschema.Sanitize(string(b))
+	if err != nil {
+		return nil, fmt.Errorf("sanitizing: %w", err)
+	}
+
+	resourceSchema, err := cfschema.NewResourceJsonSchemaDocument(document)
+	if err != nil {
+		return nil, fmt.Errorf("parsing: %w", err)
+	}
+
+	resource, err := resourceSchema.Resource()
+	if err != nil {
+		return nil, err
+	}
+
+	if err := resource.Expand(); err != nil {
+		return nil, fmt.Errorf("expanding: %w", err)
+	}
+
+	return resource, nil
+}
+
+// Attribute names reserved by Terraform or this generator.
+var reservedAttributeNames = []string{
+	"connection",
+	"count",
+	"depends_on",
+	"for_each",
+	names.AttrID,
+	"lifecycle",
+	"provider",
+	"provisioner",
+	names.AttrTimeouts,
+}
+
+// Maximum depth of nested properties. Guards against recursive definitions.
+const maxDepth = 16
+
+type emitter struct {
+	resource       *cfschema.Resource
+	tfTypeName     string
+	attributeNames map[string]string // Terraform attribute name to CloudFormation property name.
+	packages       map[string]struct{}
+	hasUpdate      bool
+}
+
+func newEmitter(resource *cfschema.Resource, tfTypeName string) *emitter {
+	_, hasUpdate := resource.Handlers["update"]
+
+	return &emitter{
+		resource:       resource,
+		tfTypeName:     tfTypeName,
+		attributeNames: make(map[string]string),
+		packages:       make(map[string]struct{}),
+		hasUpdate:      hasUpdate,
+	}
+}
+
+// property is a property being emitted along with its JSON Pointer and characteristics.
+type property struct {
+	*cfschema.Property
+	pointer    string
+	required   bool
+	readOnly   bool
+	createOnly bool
+	writeOnly  bool
+	depth      int
+}
+
+func (e *emitter) emitSchema() (string, error) {
+	var sb strings.Builder
+
+	e.use("github.com/hashicorp/terraform-plugin-framework/resource/schema")
+	e.use("github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts")
+	e.use("github.com/hashicorp/terraform-provider-aws/internal/framework")
+	e.use("github.com/hashicorp/terraform-provider-aws/names")
+
+	fmt.Fprintf(&sb, "schema.Schema{\n")
+	fmt.Fprintf(&sb, "Attributes: map[string]schema.Attribute{\n")
+
+	// The Cloud Control API resource identifier.
+	fmt.Fprintf(&sb, "names.AttrID: framework.IDAttribute(),\n")
+
+	// Resource type name suffix used to disambiguate reserved attribute names, e.g. "job_template".
+	parts := strings.Split(stringValue(e.resource.TypeName), "::")
+	prefix := toSnakeCase(parts[len(parts)-1])
+
+	for _, name := range sortedKeys(e.resource.Properties) {
+		attributeName := toSnakeCase(name)
+		if slices.Contains(reservedAttributeNames, attributeName) {
+			attributeName = prefix + "_" + attributeName
+		}
+
+		pointer := cfschema.PropertiesJsonPointerPrefix + "/" + name
+		p := e.newProperty(e.resource.Properties[name], pointer, e.resource.IsRequired(name), nil)
+
+		if err := e.emitAttribute(&sb, attributeName, name, p); err != nil {
+			return "", fmt.Errorf("%s: %w", name, err)
+		}
+	}
+
+	fmt.Fprintf(&sb, "},\n")
+	fmt.Fprintf(&sb, "Blocks: map[string]schema.Block{\n")
+	if e.hasUpdate {
+		fmt.Fprintf(&sb, "names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{Create: true, Update: true, Delete: true}),\n")
+	} else {
+		fmt.Fprintf(&sb, "names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{Create: true, Delete: true}),\n")
+	}
+	fmt.Fprintf(&sb, "},\n")
+	fmt.Fprintf(&sb, "}")
+
+	return sb.String(), nil
+}
+
+func (e *emitter) newProperty(p *cfschema.Property, pointer string, required bool, parent *property) *property {
+	result := &property{
+		Property:   p,
+		pointer:    pointer,
+		required:   required,
+		readOnly:   containsPointer(e.resource.ReadOnlyProperties, pointer),
+		createOnly: containsPointer(e.resource.CreateOnlyProperties, pointer),
+		writeOnly:  containsPointer(e.resource.WriteOnlyProperties, pointer),
+	}
+
+	if parent != nil {
+		result.depth = parent.depth + 1
+		result.readOnly = result.readOnly || parent.readOnly
+		result.writeOnly = result.writeOnly || parent.writeOnly
+	} else if !e.hasUpdate {
+		// Resources that cannot be updated must be replaced on any change.
+		result.createOnly = true
+	}
+
+	// Read-only properties are never set, so cannot be required.
+	if result.readOnly {
+		result.required = false
+		result.createOnly = false
+	}
+
+	return result
+}
+
+func (e *emitter) emitAttribute(sb *strings.Builder, attributeName, propertyName string, p *property) error {
+	if p.depth > maxDepth {
+		return fmt.Errorf("maximum nesting depth (%d) exceeded", maxDepth)
+	}
+
+	if v, ok := e.attributeNames[attributeName]; ok && v != propertyName {
+		return fmt.Errorf("attribute (%s) maps to both %s and %s properties", attributeName, v, propertyName)
+	}
+	e.attributeNames[attributeName] = propertyName
+
+	fmt.Fprintf(sb, "%s: ", names.ConstOrQuote(attributeName))
+
+	switch propertyType(p.Property) {
+	case cfschema.PropertyTypeString:
+		return e.emitPrimitiveAttribute(sb, "String", p)
+	case cfschema.PropertyTypeInteger:
+		return e.emitPrimitiveAttribute(sb, "Int64", p)
+	case cfschema.PropertyTypeNumber:
+		return e.emitPrimitiveAttribute(sb, "Float64", p)
+	case cfschema.PropertyTypeBoolean:
+		return e.emitPrimitiveAttribute(sb, "Bool", p)
+	case cfschema.PropertyTypeArray:
+		return e.emitArrayAttribute(sb, p)
+	case cfschema.PropertyTypeObject:
+		return e.emitObjectAttribute(sb, p)
+	default:
+		return fmt.Errorf("unsupported property type: %q", propertyType(p.Property))
+	}
+}
+
+func (e *emitter) emitPrimitiveAttribute(sb *strings.Builder, kind string, p *property) error {
+	fmt.Fprintf(sb, "schema.%sAttribute{\n", kind)
+	e.emitFlags(sb, kind, p)
+
+	if kind == "String" && len(p.Enum) > 0 {
+		e.use("github.com/hashicorp/terraform-plugin-framework/schema/validator")
+		e.use("github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator")
+
+		var values []string
+		for _, v := range p.Enum {
+			values = append(values, fmt.Sprintf("%q", fmt.Sprint(v)))
+		}
+
+		fmt.Fprintf(sb, "Validators: []validator.String{\n")
+		fmt.Fprintf(sb, "stringvalidator.OneOf(%s),\n", strings.Join(values, ", "))
+		fmt.Fprintf(sb, "},\n")
+	}
+
+	fmt.Fprintf(sb, "},\n")
+
+	return nil
+}
+
+func (e *emitter) emitArrayAttribute(sb *strings.Builder, p *property) error {
+	items := p.Items
+	if items == nil {
+		items = &cfschema.Property{}
+	}
+
+	// Arrays of objects with properties are nested attributes.
+	if propertyType(items) == cfschema.PropertyTypeObject && len(items.Properties) > 0 {
+		fmt.Fprintf(sb, "schema.ListNestedAttribute{\n")
+		e.emitFlags(sb, "List", p)
+		fmt.Fprintf(sb, "NestedObject: schema.NestedAttributeObject{\n")
+		if err := e.emitNestedAttributes(sb, items, p.pointer+"/*", p); err != nil {
+			return err
+		}
+		fmt.Fprintf(sb, "},\n")
+		fmt.Fprintf(sb, "},\n")
+
+		return nil
+	}
+
+	elementType, err := e.elementType(items, p.depth)
+	if err != nil {
+		return err
+	}
+
+	// Unordered arrays of unique primitive values are sets.
+	kind := "List"
+	if stringValue(p.UniqueItems) == "true" && stringValue(p.InsertionOrder) == "false" {
+		kind = "Set"
+	}
+
+	fmt.Fprintf(sb, "schema.%sAttribute{\n", kind)
+	fmt.Fprintf(sb, "ElementType: %s,\n", elementType)
+	e.emitFlags(sb, kind, p)
+	fmt.Fprintf(sb, "},\n")
+
+	return nil
+}
+
+func (e *emitter) emitObjectAttribute(sb *strings.Builder, p *property) error {
+	switch {
+	case len(p.Properties) > 0:
+		fmt.Fprintf(sb, "schema.SingleNestedAttribute{\n")
+		e.emitFlags(sb, "Object", p)
+		if err := e.emitNestedAttributes(sb, p.Property, p.pointer, p); err != nil {
+			return err
+		}
+		fmt.Fprintf(sb, "},\n")
+
+	case len(p.PatternProperties) == 1:
+		var value *cfschema.Property
+		for _, v := range p.PatternProperties {
+			value = v
+		}
+
+		elementType, err := e.elementType(value, p.depth)
+		if err != nil {
+			return err
+		}
+
+		fmt.Fprintf(sb, "schema.MapAttribute{\n")
+		fmt.Fprintf(sb, "ElementType: %s,\n", elementType)
+		e.emitFlags(sb, "Map", p)
+		fmt.Fprintf(sb, "},\n")
+
+	default:
+		// Free-form objects are JSON strings.
+		e.use("github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes")
+
+		fmt.Fprintf(sb, "schema.StringAttribute{\n")
+		fmt.Fprintf(sb, "CustomType: jsontypes.NormalizedType{},\n")
+		e.emitFlags(sb, "String", p)
+		fmt.Fprintf(sb, "},\n")
+	}
+
+	return nil
+}
+
+func (e *emitter) emitNestedAttributes(sb *strings.Builder, object *cfschema.Property, pointer string, parent *property) error {
+	fmt.Fprintf(sb, "Attributes: map[string]schema.Attribute{\n")
+
+	for _, name := range sortedKeys(object.Properties) {
+		p := e.newProperty(object.Properties[name], pointer+"/"+name, object.IsRequired(name), parent)
+
+		if err := e.emitAttribute(sb, toSnakeCase(name), name, p); err != nil {
+			return fmt.Errorf("%s: %w", name, err)
+		}
+	}
+
+	fmt.Fprintf(sb, "},\n")
+
+	return nil
+}
+
+// elementType returns the Go expression for the type of collection elements.
+func (e *emitter) elementType(p *cfschema.Property, depth int) (string, error) {
+	if depth > maxDepth {
+		return "", fmt.Errorf("maximum nesting depth (%d) exceeded", maxDepth)
+	}
+
+	e.use("github.com/hashicorp/terraform-plugin-framework/types")
+
+	switch propertyType(p) {
+	case cfschema.PropertyTypeString:
+		return "types.StringType", nil
+	case cfschema.PropertyTypeInteger:
+		return "types.Int64Type", nil
+	case cfschema.PropertyTypeNumber:
+		return "types.Float64Type", nil
+	case cfschema.PropertyTypeBoolean:
+		return "types.BoolType", nil
+	case cfschema.PropertyTypeArray:
+		if p.Items == nil {
+			return "", fmt.Errorf("array has no items")
+		}
+
+		elementType, err := e.elementType(p.Items, depth+1)
+		if err != nil {
+			return "", err
+		}
+
+		return fmt.Sprintf("types.ListType{ElemType: %s}", elementType), nil
+	case cfschema.PropertyTypeObject:
+		if len(p.Properties) > 0 {
+			return "", fmt.Errorf("nested objects within arrays of primitive values are not supported")
+		}
+
+		e.use("github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes")
+
+		return "jsontypes.NormalizedType{}", nil
+	default:
+		return "", fmt.Errorf("unsupported element type: %q", propertyType(p))
+	}
+}
+
+// emitFlags emits the Required, Optional and Computed flags and plan modifiers.
+//
+// Read-only properties are computed.
+// Optional properties are also computed as the service may return default values, except for write-only properties
+// which are never returned.
+// Changes to create-only properties require replacement.
+func (e *emitter) emitFlags(sb *strings.Builder, kind string, p *property) {
+	var modifiers []string
+
+	switch {
+	case p.readOnly:
+		fmt.Fprintf(sb, "Computed: true,\n")
+		modifiers = append(modifiers, "UseStateForUnknown()")
+	case p.required:
+		fmt.Fprintf(sb, "Required: true,\n")
+	case p.writeOnly:
+		fmt.Fprintf(sb, "Optional: true,\n")
+	default:
+		fmt.Fprintf(sb, "Optional: true,\n")
+		fmt.Fprintf(sb, "Computed: true,\n")
+		modifiers = append(modifiers, "UseStateForUnknown()")
+	}
+
+	if p.createOnly {
+		modifiers = append([]string{"RequiresReplace()"}, modifiers...)
+	}
+
+	if len(modifiers) == 0 {
+		return
+	}
+
+	pkg := strings.ToLower(kind) + "planmodifier"
+	e.use("github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier")
+	e.use("github.com/hashicorp/terraform-plugin-framework/resource/schema/" + pkg)
+
+	fmt.Fprintf(sb, "PlanModifiers: []planmodifier.%s{\n", kind)
+	for _, v := range modifiers {
+		fmt.Fprintf(sb, "%s.%s,\n", pkg, v)
+	}
+	fmt.Fprintf(sb, "},\n")
+}
+
+func (e *emitter) use(pkg string) {
+	e.packages[pkg] = struct{}{}
+}
+
+func (e *emitter) imports() []string {
+	return sortedKeys(e.packages)
+}
+
+func (e *emitter) sortedAttributeNames() []string {
+	return sortedKeys(e.attributeNames)
+}
+
+// propertyType returns the property's type.
+// Untyped properties are objects, either with nested properties or free-form.
+func propertyType(p *cfschema.Property) string {
+	if t := p.Type.String(); t != "" {
+		return t
+	}
+
+	return cfschema.PropertyTypeObject
+}
+
+func containsPointer(pointers cfschema.PropertyJsonPointers, pointer string) bool {
+	return slices.ContainsFunc(pointers, func(v cfschema.PropertyJsonPointer) bool {
+		return string(v) == pointer
+	})
+}
+
+func pointers(v cfschema.PropertyJsonPointers) []string {
+	var result []string
+
+	for _, v := range v {
+		result = append(result, string(v))
+	}
+
+	return result
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+
+	for k := range m {
+		keys = append(keys, k)
+	}
+
+	sort.Strings(keys)
+
+	return keys
+}
+
+func stringValue[T any](v *T) string {
+	if v == nil {
+		return ""
+	}
+
+	return fmt.Sprint(*v)
+}
+
+func toSnakeCase(str string) string {
+	result := regexache.MustCompile("(.)([A-Z][a-z]+)").ReplaceAllString(str, "${1}_${2}")
+	result = regexache.MustCompile("([0-9a-z])([A-Z])").ReplaceAllString(result, "${1}_${2}")
+	return strings.ToLower(result)
+}
@@ -0,0 +1,382 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+//go:build generate
+// +build generate
+
+package main
+
+import (
+	_ "embed"
+	"errors"
+	"fmt"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/YakDriver/regexache"
+	"github.com/hashicorp/terraform-provider-aws/internal/generate/common"
+	"github.com/hashicorp/terraform-provider-aws/names"
+	"github.com/hashicorp/terraform-provider-aws/names/data"
+)
+
+func main() {
+	g := common.NewGenerator()
+
+	serviceData, err := data.ReadAllServiceData()
+
+	if err != nil {
+		g.Fatalf("error reading service data: %s", err)
+	}
+
+	servicePackage := os.Getenv("GOPACKAGE")
+
+	g.Infof("Generating resource tests for internal/service/%s", servicePackage)
+
+	var (
+		serviceRecord data.ServiceRecord
+		found         bool
+	)
+
+	for _, l := range serviceData {
+		// See internal/generate/namesconsts/main.go.
+		p := l.ProviderPackage()
+
+		if p != servicePackage {
+			continue
+		}
+
+		serviceRecord = l
+		found = true
+		break
+	}
+
+	if !found {
+		g.Fatalf("service package not found: %s", servicePackage)
+	}
+
+	// Look for Terraform Plugin Framework and SDK resource annotations.
+	// These annotations are implemented as comments on factory functions.
+	v := &visitor{
+		g: g,
+	}
+
+	v.processDir(".")
+
+	if err := errors.Join(v.errs...); err != nil {
+		g.Fatalf("%s", err.Error())
+	}
+
+	for _, resource := range v.resources {
+		sourceName := resource.FileName
+		ext := filepath.Ext(sourceName)
+		sourceName = strings.TrimSuffix(sourceName, ext)
+
+		// Prefer a dedicated configuration template. Otherwise use the tagging tests' template, without tags.
+		var configTmplFile string
+		for _, v := range []string{"basic", "tags"} {
+			file := path.Join("testdata", "tmpl", fmt.Sprintf("%s_%s.gtpl", sourceName, v))
+			if _, err := os.Stat(file); err == nil {
+				configTmplFile = file
+				break
+			} else if !errors.Is(err, os.ErrNotExist) {
+				g.Fatalf("opening config template %q: %s", file, err)
+			}
+		}
+
+		if configTmplFile == "" {
+			g.Infof("Skipping resource tests for %s: no configuration template found", resource.TypeName)
+			continue
+		}
+
+		b, err := os.ReadFile(configTmplFile)
+		if err != nil {
+			g.Fatalf("reading %q: %s", configTmplFile, err)
+		}
+
+		resource.ProviderNameUpper = serviceRecord.ProviderNameUpper()
+		resource.ProviderPackage = servicePackage
+
+		filename := fmt.Sprintf("%s_resource_gen_test.go", sourceName)
+
+		d := g.NewGoFileDestination(filename)
+		templates, err := template.New("resourcetests").Parse(testGoTmpl)
+		if err != nil {
+			g.Fatalf("parsing base Go test template: %s", err)
+		}
+
+		if err := d.WriteTemplateSet(templates, resource); err != nil {
+			g.Fatalf("error generating %q service package data: %s", servicePackage, err)
+		}
+
+		if err := d.Write(); err != nil {
+			g.Fatalf("generating file (%s): %s", filename, err)
+		}
+
+		generateTestConfig(g, path.Join("testdata", resource.Name, "basic"), configTmplFile, string(b))
+	}
+}
+
+type implementation string
+
+const (
+	implementationFramework implementation = "framework"
+	implementationSDK       implementation = "sdk"
+)
+
+type ResourceDatum struct {
+	ProviderPackage   string
+	ProviderNameUpper string
+	Name              string
+	TypeName          string
+	ExistsTypeName    string
+	FileName          string
+	Generator         string
+	ImportIgnore      []string
+	Implementation    implementation
+	Serialize         bool
+	PreCheck          bool
+	GoImports         []goImport
+}
+
+type goImport struct {
+	Path  string
+	Alias string
+}
+
+//go:embed test.go.gtpl
+var testGoTmpl string
+
+//go:embed test.tf.gtpl
+var testTfTmpl string
+
+// Annotation processing.
+var (
+	annotation = regexache.MustCompile(`^//\s*@([0-9A-Za-z]+)(\((.*)\))?\s*$`)
+)
+
+type visitor struct {
+	errs []error
+	g    *common.Generator
+
+	fileName     string
+	functionName string
+	packageName  string
+
+	resources []ResourceDatum
+}
+
+// processDir scans a single service package directory and processes contained Go sources files.
+func (v *visitor) processDir(path string) {
+	fileSet := token.NewFileSet()
+	packageMap, err := parser.ParseDir(fileSet, path, func(fi os.FileInfo) bool {
+		// Skip tests.
+		return !strings.HasSuffix(fi.Name(), "_test.go")
+	}, parser.ParseComments)
+
+	if err != nil {
+		v.errs = append(v.errs, fmt.Errorf("parsing (%s): %w", path, err))
+
+		return
+	}
+
+	for name, pkg := range packageMap {
+		v.packageName = name
+
+		for name, file := range pkg.Files {
+			v.fileName = name
+
+			v.processFile(file)
+
+			v.fileName = ""
+		}
+
+		v.packageName = ""
+	}
+}
+
+// processFile processes a single Go source file.
+func (v *visitor) processFile(file *ast.File) {
+	ast.Walk(v, file)
+}
+
+// processFuncDecl processes a single Go function.
+// The function's comments are scanned for annotations indicating a Plugin Framework or SDK resource.
+func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
+	v.functionName = funcDecl.Name.Name
+
+	d := ResourceDatum{
+		FileName: v.fileName,
+	}
+	skip := false
+
+	for _, line := range funcDecl.Doc.List {
+		line := line.Text
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 {
+			switch annotationName := m[1]; annotationName {
+			case "FrameworkResource":
+				d.Implementation = implementationFramework
+				args := common.ParseArgs(m[3])
+				if len(args.Positional) == 0 {
+					// The type name is only known at runtime.
+					v.g.Infof("Skipping resource tests for %s.%s: no type name", v.packageName, v.functionName)
+					skip = true
+					continue
+				}
+				d.TypeName = args.Positional[0]
+				if attr, ok := args.Keyword["name"]; ok {
+					d.Name = strings.ReplaceAll(attr, " ", "")
+				}
+
+			case "SDKResource":
+				d.Implementation = implementationSDK
+				args := common.ParseArgs(m[3])
+				if len(args.Positional) == 0 {
+					v.errs = append(v.errs, fmt.Errorf("no type name: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+					continue
+				}
+				d.TypeName = args.Positional[0]
+				if attr, ok := args.Keyword["name"]; ok {
+					d.Name = strings.ReplaceAll(attr, " ", "")
+				}
+
+			case "Testing":
+				args := common.ParseArgs(m[3])
+				if attr, ok := args.Keyword["existsType"]; ok {
+					if typeName, importSpec, err := parseIdentifierSpec(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("%s: %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+						continue
+					} else {
+						d.ExistsTypeName = typeName
+						if importSpec != nil {
+							d.GoImports = append(d.GoImports, *importSpec)
+						}
+					}
+				}
+				if attr, ok := args.Keyword["generator"]; ok {
+					if funcName, importSpec, err := parseIdentifierSpec(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("%s: %s: %w", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName), err))
+						continue
+					} else {
+						d.Generator = funcName
+						if importSpec != nil {
+							d.GoImports = append(d.GoImports, *importSpec)
+						}
+					}
+				}
+				if attr, ok := args.Keyword["importIgnore"]; ok {
+					d.ImportIgnore = strings.Split(attr, ";")
+
+					for i, val := range d.ImportIgnore {
+						d.ImportIgnore[i] = names.ConstOrQuote(val)
+					}
+				}
+				if attr, ok := args.Keyword["name"]; ok {
+					d.Name = strings.ReplaceAll(attr, " ", "")
+				}
+				if attr, ok := args.Keyword["preCheck"]; ok {
+					if b, err := strconv.ParseBool(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("invalid preCheck value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+						continue
+					} else {
+						d.PreCheck = b
+					}
+				}
+				if attr, ok := args.Keyword["serialize"]; ok {
+					if b, err := strconv.ParseBool(attr); err != nil {
+						v.errs = append(v.errs, fmt.Errorf("invalid serialize value: %q at %s. Should be boolean value.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+						continue
+					} else {
+						d.Serialize = b
+					}
+				}
+				if attr, ok := args.Keyword["resourceTests"]; ok {
+					switch attr {
+					case "true":
+						// no-op
+
+					case "false":
+						v.g.Infof("Skipping resource tests for %s.%s", v.packageName, v.functionName)
+						skip = true
+
+					default:
+						v.errs = append(v.errs, fmt.Errorf("invalid resourceTests value: %q at %s.", attr, fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+						continue
+					}
+				}
+			}
+		}
+	}
+
+	if d.TypeName != "" && !skip {
+		v.resources = append(v.resources, d)
+	}
+
+	v.functionName = ""
+}
+
+// Visit is called for each node visited by ast.Walk.
+func (v *visitor) Visit(node ast.Node) ast.Visitor {
+	// Look at functions (not methods) with comments.
+	if funcDecl, ok := node.(*ast.FuncDecl); ok && funcDecl.Recv == nil && funcDecl.Doc != nil {
+		v.processFuncDecl(funcDecl)
+	}
+
+	return v
+}
+
+func generateTestConfig(g *common.Generator, dirPath, configTmplFile, configTmpl string) {
+	if err := os.MkdirAll(dirPath, 0755); err != nil {
+		g.Fatalf("creating test directory %q: %s", dirPath, err)
+	}
+
+	mainPath := path.Join(dirPath, "main_gen.tf")
+	tf := g.NewUnformattedFileDestination(mainPath)
+
+	tfTemplates, err := template.New("resourcetests").Parse(testTfTmpl)
+	if err != nil {
+		g.Fatalf("parsing base Terraform config template: %s", err)
+	}
+
+	_, err = tfTemplates.New("body").Parse(configTmpl)
+	if err != nil {
+		g.Fatalf("parsing config template %q: %s", configTmplFile, err)
+	}
+
+	if err := tf.WriteTemplateSet(tfTemplates, nil); err != nil {
+		g.Fatalf("error generating Terraform file %q: %s", mainPath, err)
+	}
+
+	if err := tf.Write(); err != nil {
+		g.Fatalf("generating file (%s): %s", mainPath, err)
+	}
+}
+
+func parseIdentifierSpec(s string) (string, *goImport, error) {
+	parts := strings.Split(s, ";")
+	switch len(parts) {
+	case 1:
+		return parts[0], nil, nil
+
+	case 2:
+		return parts[1], &goImport{
+			Path: parts[0],
+		}, nil
+
+	case 3:
+		return parts[2], &goImport{
+			Path:  parts[0],
+			Alias: parts[1],
+		}, nil
+
+	default:
+		return "", nil, fmt.Errorf("invalid generator value: %q", s)
+	}
+}
@@ -40,6 +40,7 @@ func main() {
 			"paymentcryptography", // Resolver modifies URL
 			"route53profiles",     // Resolver modifies URL
 			"s3control",           // Resolver modifies URL
+			"timestreamquery",     // Uses endpoint discovery
 			"timestreamwrite":     // Uses endpoint discovery
 			continue
 		}
@@ -61,6 +62,7 @@ func main() {
 			APICall:           l.EndpointAPICall(),
 			APICallParams:     l.EndpointAPIParams(),
 			AwsEnvVar:         l.AWSServiceEnvVar(),
+			URLServiceID:      strings.ReplaceAll(strings.ToLower(l.SDKID()), " ", "-"),
 			ConfigParameter:   l.AWSConfigParameter(),
 			DeprecatedEnvVar:  l.DeprecatedEnvVar(),
 			TFAWSEnvVar:       l.TFAWSEnvVar(),
@@ -122,6 +124,7 @@ type TemplateData struct {
 	APICall                           string
 	APICallParams                     string
 	AwsEnvVar                         string
+	URLServiceID                      string
 	ConfigParameter                   string
 	DeprecatedEnvVar                  string
 	TFAWSEnvVar                       string
@@ -109,6 +109,9 @@ type ResourceDatum struct {
 	TransparentTagging      bool
 	TagsIdentifierAttribute string
 	TagsResourceType        string
+	ARNTemplate             string
+	ARNIDTemplate           string
+	NoMemoize               bool
 }
 
 type ServiceDatum struct {
@@ -186,12 +189,34 @@ func (v *visitor) processFile(file *ast.File) {
 func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 	v.functionName = funcDecl.Name.Name
 
-	// Look first for tagging annotations.
+	// Look first for tagging, ARN import and memoization annotations.
 	d := ResourceDatum{}
 
 	for _, line := range funcDecl.Doc.List {
 		line := line.Text
 
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "NoMemoize" {
+			d.NoMemoize = true
+		}
+
+		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "ARNImport" {
+			args := common.ParseArgs(m[3])
+
+			if d.ARNTemplate != "" {
+				v.errs = append(v.errs, fmt.Errorf("multiple ARNImport annotations: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			if attr, ok := args.Keyword["template"]; ok {
+				d.ARNTemplate = attr
+			} else {
+				v.errs = append(v.errs, fmt.Errorf("no ARN template: %s", fmt.Sprintf("%s.%s", v.packageName, v.functionName)))
+			}
+
+			if attr, ok := args.Keyword["id"]; ok {
+				d.ARNIDTemplate = attr
+			}
+		}
+
 		if m := annotation.FindStringSubmatch(line); len(m) > 0 && m[1] == "Tags" {
 			args := common.ParseArgs(m[3])
 
@@ -262,7 +287,7 @@ func (v *visitor) processFuncDecl(funcDecl *ast.FuncDecl) {
 				} else {
 					v.sdkResources[typeName] = d
 				}
-			case "Tags":
+			case "ARNImport", "NoMemoize", "Tags":
 				// Handled above.
 			case "Testing":
 				// Ignored.
@@ -9,6 +9,7 @@ package main
 import (
 	_ "embed"
 	"errors"
+	"flag"
 	"fmt"
 	"io/fs"
 	"os"
@@ -27,10 +28,19 @@ type TemplateData struct {
 	Services    []ServiceDatum
 }
 
+var (
+	serviceDir = flag.String("ServiceDir", "../service", "path to the service packages directory")
+)
+
 func main() {
-	const (
-		filename = `register_gen_test.go`
-	)
+	filename := `register_gen_test.go`
+
+	flag.Parse()
+	args := flag.Args()
+	if len(args) > 0 {
+		filename = args[0]
+	}
+
 	g := common.NewGenerator()
 
 	packageName := os.Getenv("GOPACKAGE")
@@ -54,11 +64,11 @@ func main() {
 
 		p := l.ProviderPackage()
 
-		if _, err := os.Stat(fmt.Sprintf("../service/%s", p)); err != nil || errors.Is(err, fs.ErrNotExist) {
+		if _, err := os.Stat(fmt.Sprintf("%s/%s", *serviceDir, p)); err != nil || errors.Is(err, fs.ErrNotExist) {
 			continue
 		}
 
-		if _, err := os.Stat(fmt.Sprintf("../service/%s/sweep.go", p)); err != nil || errors.Is(err, fs.ErrNotExist) {
+		if _, err := os.Stat(fmt.Sprintf("%s/%s/sweep.go", *serviceDir, p)); err != nil || errors.Is(err, fs.ErrNotExist) {
 			g.Infof("No sweepers for %q", p)
 			continue
 		}
@@ -5,13 +5,18 @@ package fwprovider
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"math/big"
+	"sort"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/terraform-plugin-framework/datasource"
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/path"
 	"github.com/hashicorp/terraform-plugin-framework/resource"
 	fwtypes "github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
@@ -197,16 +202,20 @@ type contextFunc func(context.Context, *conns.AWSClient) context.Context
 type wrappedDataSource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
+	typeName         string
 	inner            datasource.DataSourceWithConfigure
 	interceptors     dataSourceInterceptors
 	meta             *conns.AWSClient
+	noMemoize        bool
 }
 
-func newWrappedDataSource(bootstrapContext contextFunc, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors) datasource.DataSourceWithConfigure {
+func newWrappedDataSource(bootstrapContext contextFunc, typeName string, inner datasource.DataSourceWithConfigure, interceptors dataSourceInterceptors, noMemoize bool) datasource.DataSourceWithConfigure {
 	return &wrappedDataSource{
 		bootstrapContext: bootstrapContext,
+		typeName:         typeName,
 		inner:            inner,
 		interceptors:     interceptors,
+		noMemoize:        noMemoize,
 	}
 }
 
@@ -226,8 +235,52 @@ func (w *wrappedDataSource) Read(ctx context.Context, request datasource.ReadReq
 		return response.Diagnostics
 	}
 	ctx = w.bootstrapContext(ctx, w.meta)
-	diags := interceptedDataSourceReadHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
-	response.Diagnostics = diags
+	read := func() {
+		diags := interceptedDataSourceReadHandler(w.interceptors.read(), f, w.meta)(ctx, request, response)
+		response.Diagnostics = diags
+	}
+
+	if w.noMemoize || w.meta == nil {
+		read()
+		return
+	}
+
+	config, err := canonicalJSON(request.Config.Raw)
+	if err != nil {
+		read()
+		return
+	}
+
+	v, ok := w.meta.MemoizeDataSourceRead(w.typeName, config, func() (any, bool) {
+		read()
+
+		if response.Diagnostics.HasError() {
+			return nil, false
+		}
+
+		return memoizedDataSourceRead{
+			state: response.State.Raw.Copy(),
+			diags: response.Diagnostics,
+		}, true
+	})
+
+	if !ok {
+		return
+	}
+
+	tflog.Debug(ctx, "using memoized data source read", map[string]any{
+		"type_name": w.typeName,
+	})
+
+	memoized := v.(memoizedDataSourceRead)
+	response.State.Raw = memoized.state.Copy()
+	response.Diagnostics = append(diag.Diagnostics{}, memoized.diags...)
+}
+
+// memoizedDataSourceRead is the memoized result of a Plugin Framework data source read.
+type memoizedDataSourceRead struct {
+	state tftypes.Value
+	diags diag.Diagnostics
 }
 
 func (w *wrappedDataSource) Configure(ctx context.Context, request datasource.ConfigureRequest, response *datasource.ConfigureResponse) {
@@ -255,13 +308,16 @@ type wrappedResource struct {
 	inner            resource.ResourceWithConfigure
 	interceptors     resourceInterceptors
 	meta             *conns.AWSClient
+	// arn is used to resolve the native ID from an ARN import ID.
+	arn *types.ServicePackageResourceARN
 }
 
-func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors) resource.ResourceWithConfigure {
+func newWrappedResource(bootstrapContext contextFunc, inner resource.ResourceWithConfigure, interceptors resourceInterceptors, arn *types.ServicePackageResourceARN) resource.ResourceWithConfigure {
 	return &wrappedResource{
 		bootstrapContext: bootstrapContext,
 		inner:            inner,
 		interceptors:     interceptors,
+		arn:              arn,
 	}
 }
 
@@ -326,6 +382,40 @@ func (w *wrappedResource) Configure(ctx context.Context, request resource.Config
 func (w *wrappedResource) ImportState(ctx context.Context, request resource.ImportStateRequest, response *resource.ImportStateResponse) {
 	if v, ok := w.inner.(resource.ResourceWithImportState); ok {
 		ctx = w.bootstrapContext(ctx, w.meta)
+
+		id := request.ID
+		if !arn.IsARN(id) {
+			v.ImportState(ctx, request, response)
+
+			return
+		}
+
+		if w.arn == nil {
+			v.ImportState(ctx, request, response)
+
+			if response.Diagnostics.HasError() {
+				response.Diagnostics.AddError(
+					"Import By ARN Not Supported",
+					fmt.Sprintf("This resource does not support import by ARN (%s). Import using the resource's native ID instead.", id),
+				)
+			}
+
+			return
+		}
+
+		resolvedID, err := w.arn.ResolveID(id)
+		if err != nil {
+			response.Diagnostics.AddError("Invalid Import ID", err.Error())
+
+			return
+		}
+
+		tflog.Debug(ctx, "resolved import ID from ARN", map[string]any{
+			names.AttrARN: id,
+			names.AttrID:  resolvedID,
+		})
+		request.ID = resolvedID
+
 		v.ImportState(ctx, request, response)
 
 		return
@@ -653,3 +743,108 @@ func (r tagsResourceInterceptor) update(ctx context.Context, request resource.Up
 func (r tagsResourceInterceptor) delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse, meta *conns.AWSClient, when when, diags diag.Diagnostics) (context.Context, diag.Diagnostics) {
 	return ctx, diags
 }
+
+// canonicalJSON returns a canonical JSON encoding of the specified wholly known value.
+// Object attributes and map elements are encoded in sorted order, as are set elements.
+func canonicalJSON(v tftypes.Value) ([]byte, error) {
+	result, err := canonicalValue(v)
+	if err != nil {
+		return nil, err
+	}
+
+	return json.Marshal(result)
+}
+
+func canonicalValue(v tftypes.Value) (any, error) {
+	if !v.IsKnown() {
+		return nil, fmt.Errorf("unknown value")
+	}
+
+	if v.IsNull() {
+		return nil, nil
+	}
+
+	switch typ := v.Type(); {
+	case typ.Is(tftypes.Bool):
+		var b bool
+		if err := v.As(&b); err != nil {
+			return nil, err
+		}
+
+		return b, nil
+
+	case typ.Is(tftypes.Number):
+		var f big.Float
+		if err := v.As(&f); err != nil {
+			return nil, err
+		}
+
+		return json.Number(f.Text('g', -1)), nil
+
+	case typ.Is(tftypes.String):
+		var s string
+		if err := v.As(&s); err != nil {
+			return nil, err
+		}
+
+		return s, nil
+
+	case typ.Is(tftypes.List{}), typ.Is(tftypes.Set{}), typ.Is(tftypes.Tuple{}):
+		var elems []tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return nil, err
+		}
+
+		result := make([]any, 0, len(elems))
+		for _, elem := range elems {
+			v, err := canonicalValue(elem)
+			if err != nil {
+				return nil, err
+			}
+
+			result = append(result, v)
+		}
+
+		if typ.Is(tftypes.Set{}) {
+			encoded := make([]string, 0, len(result))
+			for _, v := range result {
+				b, err := json.Marshal(v)
+				if err != nil {
+					return nil, err
+				}
+
+				encoded = append(encoded, string(b))
+			}
+			sort.Strings(encoded)
+
+			result = make([]any, 0, len(encoded))
+			for _, v := range encoded {
+				result = append(result, json.RawMessage(v))
+			}
+		}
+
+		return result, nil
+
+	case typ.Is(tftypes.Map{}), typ.Is(tftypes.Object{}):
+		var elems map[string]tftypes.Value
+		if err := v.As(&elems); err != nil {
+			return nil, err
+		}
+
+		// encoding/json sorts map keys.
+		result := make(map[string]any, len(elems))
+		for k, elem := range elems {
+			v, err := canonicalValue(elem)
+			if err != nil {
+				return nil, err
+			}
+
+			result[k] = v
+		}
+
+		return result, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported type: %s", typ)
+	}
+}
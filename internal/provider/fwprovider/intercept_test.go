@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package fwprovider
+
+import (
+	"math/big"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+)
+
+func TestCanonicalJSON(t *testing.T) {
+	t.Parallel()
+
+	typ := tftypes.Object{
+		AttributeTypes: map[string]tftypes.Type{
+			"count":  tftypes.Number,
+			"filter": tftypes.Set{ElementType: tftypes.String},
+			"name":   tftypes.String,
+			"tags":   tftypes.Map{ElementType: tftypes.String},
+		},
+	}
+	newValue := func(filter []string, tags map[string]string) tftypes.Value {
+		filters := make([]tftypes.Value, 0, len(filter))
+		for _, v := range filter {
+			filters = append(filters, tftypes.NewValue(tftypes.String, v))
+		}
+		elems := make(map[string]tftypes.Value, len(tags))
+		for k, v := range tags {
+			elems[k] = tftypes.NewValue(tftypes.String, v)
+		}
+
+		return tftypes.NewValue(typ, map[string]tftypes.Value{
+			"count":  tftypes.NewValue(tftypes.Number, big.NewFloat(1.5)),
+			"filter": tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, filters),
+			"name":   tftypes.NewValue(tftypes.String, nil),
+			"tags":   tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, elems),
+		})
+	}
+
+	got, err := canonicalJSON(newValue([]string{"b", "a"}, map[string]string{"k2": "v2", "k1": "v1"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if want := `{"count":1.5,"filter":["a","b"],"name":null,"tags":{"k1":"v1","k2":"v2"}}`; string(got) != want {
+		t.Errorf("canonicalJSON = %s, want %s", got, want)
+	}
+
+	other, err := canonicalJSON(newValue([]string{"a", "b"}, map[string]string{"k1": "v1", "k2": "v2"}))
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	if string(got) != string(other) {
+		t.Errorf("canonicalJSON not canonical: %s, %s", got, other)
+	}
+
+	if _, err := canonicalJSON(tftypes.NewValue(tftypes.String, tftypes.UnknownValue)); err == nil {
+		t.Error("expected error for unknown value")
+	}
+}
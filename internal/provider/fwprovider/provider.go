@@ -68,6 +68,14 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Protocol to use with EC2 metadata service endpoint.Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"endpoint_url": schema.StringAttribute{
+				Optional:    true,
+				Description: "Base URL used for all service endpoints not otherwise configured. Useful for connecting to AWS compatible solutions such as LocalStack.",
+			},
+			"endpoint_url_template": schema.StringAttribute{
+				Optional:    true,
+				Description: "Template used to build service endpoint URLs not otherwise configured. The placeholders `{service}` and `{region}` are replaced with the hyphenated, lower-case AWS SDK service ID and AWS Region.",
+			},
 			"forbidden_account_ids": schema.SetAttribute{
 				ElementType: types.StringType,
 				Optional:    true,
@@ -92,6 +100,10 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 				Optional:    true,
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"partitions_file": schema.StringAttribute{
+				Optional:    true,
+				Description: "Path to an AWS SDK endpoints.json-style file defining additional partitions and regions. Can also be configured using the `TF_AWS_PARTITIONS_FILE` environment variable.",
+			},
 			"profile": schema.StringAttribute{
 				Optional:    true,
 				Description: "The profile for API operations. If not set, the default profile\ncreated with `aws configure` will be used.",
@@ -267,6 +279,28 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"distributed_lock": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to serialize changes to shared objects across provider processes.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"directory": schema.StringAttribute{
+							Optional:    true,
+							Description: "Local directory in which lock files are created.",
+						},
+						"dynamodb_table": schema.StringAttribute{
+							Optional:    true,
+							Description: "Name of the DynamoDB table in which leases are stored. The table's partition key must be a string named `LockID`.",
+						},
+						"lease_duration": schema.StringAttribute{
+							Optional:    true,
+							Description: "Duration of lock leases, which are renewed while held. Valid time units are ns, us (or µs), ms, s, h, or m. Defaults to `30s`.",
+						},
+					},
+				},
+			},
 			"endpoints": endpointsBlock(),
 			"ignore_tags": schema.ListNestedBlock{
 				Validators: []validator.List{
@@ -288,6 +322,21 @@ func (p *fwprovider) Schema(ctx context.Context, req provider.SchemaRequest, res
 					},
 				},
 			},
+			"memoize_data_sources": schema.ListNestedBlock{
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				Description: "Configuration block with settings to memoize data source reads. Reads of a data source with the same configuration return the same result for the lifetime of the provider instance.",
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"exclude": schema.SetAttribute{
+							ElementType: types.StringType,
+							Optional:    true,
+							Description: "Data source type names whose reads are never memoized.",
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -363,7 +412,7 @@ func (p *fwprovider) DataSources(ctx context.Context) []func() datasource.DataSo
 			}
 
 			dataSources = append(dataSources, func() datasource.DataSource {
-				return newWrappedDataSource(bootstrapContext, inner, interceptors)
+				return newWrappedDataSource(bootstrapContext, typeName, inner, interceptors, v.NoMemoize)
 			})
 		}
 	}
@@ -443,7 +492,7 @@ func (p *fwprovider) Resources(ctx context.Context) []func() resource.Resource {
 			}
 
 			resources = append(resources, func() resource.Resource {
-				return newWrappedResource(bootstrapContext, inner, interceptors)
+				return newWrappedResource(bootstrapContext, inner, interceptors, v.ARN)
 			})
 		}
 	}
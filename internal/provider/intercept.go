@@ -5,9 +5,12 @@ package provider
 
 import (
 	"context"
+	"fmt"
 
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
 	"github.com/hashicorp/aws-sdk-go-base/v2/awsv1shim/v2/tfawserr"
 	"github.com/hashicorp/go-cty/cty"
+	ctyjson "github.com/hashicorp/go-cty/cty/json"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -142,10 +145,90 @@ type wrappedDataSource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	interceptors     interceptorItems
+	typeName         string
+	// schemaMap returns the data source's schema. Used to replay memoized reads.
+	schemaMap func() map[string]*schema.Schema
+	noMemoize bool
 }
 
 func (ds *wrappedDataSource) Read(f schema.ReadContextFunc) schema.ReadContextFunc {
-	return interceptedHandler(ds.bootstrapContext, ds.interceptors, f, Read)
+	f = interceptedHandler(ds.bootstrapContext, ds.interceptors, f, Read)
+
+	if ds.noMemoize {
+		return f
+	}
+
+	return ds.memoizedRead(f)
+}
+
+// memoizedDataSourceRead is the memoized result of a Plugin SDK v2 data source read.
+type memoizedDataSourceRead struct {
+	id     string
+	values map[string]any
+	diags  diag.Diagnostics
+}
+
+// memoizedRead returns a handler that memoizes the results of the specified data source Read handler.
+// Reads are memoized only if data source memoization is configured for the provider instance.
+func (ds *wrappedDataSource) memoizedRead(f schema.ReadContextFunc) schema.ReadContextFunc {
+	return func(ctx context.Context, d *schema.ResourceData, meta any) diag.Diagnostics {
+		c, ok := meta.(*conns.AWSClient)
+		if !ok {
+			return f(ctx, d, meta)
+		}
+
+		config := d.GetRawConfig()
+		if config.IsNull() || !config.IsWhollyKnown() {
+			return f(ctx, d, meta)
+		}
+
+		// Object attributes and map elements are marshaled in sorted order.
+		key, err := ctyjson.Marshal(config, config.Type())
+		if err != nil {
+			return f(ctx, d, meta)
+		}
+
+		var diags diag.Diagnostics
+		v, ok := c.MemoizeDataSourceRead(ds.typeName, key, func() (any, bool) {
+			diags = f(ctx, d, meta)
+
+			if diags.HasError() {
+				return nil, false
+			}
+
+			read := memoizedDataSourceRead{
+				id:     d.Id(),
+				values: make(map[string]any),
+				diags:  diags,
+			}
+			for k := range ds.schemaMap() {
+				read.values[k] = d.Get(k)
+			}
+
+			return read, true
+		})
+
+		if !ok {
+			return diags
+		}
+
+		ctx = ds.bootstrapContext(ctx, meta)
+		tflog.Debug(ctx, "using memoized data source read", map[string]any{
+			"type_name": ds.typeName,
+		})
+
+		read := v.(memoizedDataSourceRead)
+		diags = append(diag.Diagnostics{}, read.diags...)
+
+		d.SetId(read.id)
+		for k, v := range read.values {
+			if err := d.Set(k, v); err != nil {
+				return sdkdiag.AppendErrorf(diags, "setting %s: %s", k, err)
+			}
+		}
+
+		return diags
+	}
 }
 
 // wrappedResource represents an interceptor dispatcher for a Plugin SDK v2 resource.
@@ -153,6 +236,8 @@ type wrappedResource struct {
 	// bootstrapContext is run on all wrapped methods before any interceptors.
 	bootstrapContext contextFunc
 	interceptors     interceptorItems
+	// arn is used to resolve the native ID from an ARN import ID.
+	arn *types.ServicePackageResourceARN
 }
 
 func (r *wrappedResource) Create(f schema.CreateContextFunc) schema.CreateContextFunc {
@@ -175,6 +260,31 @@ func (r *wrappedResource) State(f schema.StateContextFunc) schema.StateContextFu
 	return func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
 		ctx = r.bootstrapContext(ctx, meta)
 
+		id := d.Id()
+		if !arn.IsARN(id) {
+			return f(ctx, d, meta)
+		}
+
+		if r.arn == nil {
+			v, err := f(ctx, d, meta)
+			if err != nil {
+				return nil, fmt.Errorf("%w\n\nThis resource does not support import by ARN (%s). Import using the resource's native ID instead", err, id)
+			}
+
+			return v, nil
+		}
+
+		v, err := r.arn.ResolveID(id)
+		if err != nil {
+			return nil, fmt.Errorf("resolving import ID: %w", err)
+		}
+
+		tflog.Debug(ctx, "resolved import ID from ARN", map[string]any{
+			"arn":        id,
+			names.AttrID: v,
+		})
+		d.SetId(v)
+
 		return f(ctx, d, meta)
 	}
 }
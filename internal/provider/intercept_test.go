@@ -5,11 +5,13 @@ package provider
 
 import (
 	"context"
+	"errors"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/types"
 )
 
 func TestInterceptorsWhy(t *testing.T) {
@@ -93,3 +95,85 @@ func TestInterceptedHandler(t *testing.T) {
 		t.Errorf("length of diags = %v, want %v", got, want)
 	}
 }
+
+func TestWrappedResourceStateImportByARN(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	bootstrapContext := func(ctx context.Context, meta any) context.Context {
+		return ctx
+	}
+	template := &types.ServicePackageResourceARN{
+		Template:   "arn:{partition}:sns:{region}:{account_id}:{name}",
+		IDTemplate: "{name}",
+	}
+	passthrough := func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		return []*schema.ResourceData{d}, nil
+	}
+	failing := func(ctx context.Context, d *schema.ResourceData, meta any) ([]*schema.ResourceData, error) {
+		return nil, errors.New("invalid ID")
+	}
+
+	testCases := map[string]struct {
+		arn         *types.ServicePackageResourceARN
+		f           schema.StateContextFunc
+		importID    string
+		expectedID  string
+		expectError bool
+	}{
+		"native ID": {
+			arn:        template,
+			f:          passthrough,
+			importID:   "my-topic",
+			expectedID: "my-topic",
+		},
+		"ARN resolved": {
+			arn:        template,
+			f:          passthrough,
+			importID:   "arn:aws:sns:us-west-2:123456789012:my-topic", //lintignore:AWSAT003,AWSAT005
+			expectedID: "my-topic",
+		},
+		"ARN not matching template": {
+			arn:         template,
+			f:           passthrough,
+			importID:    "arn:aws:sqs:us-west-2:123456789012:my-queue", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		"no template ARN passthrough": {
+			f:          passthrough,
+			importID:   "arn:aws:sns:us-west-2:123456789012:my-topic", //lintignore:AWSAT003,AWSAT005
+			expectedID: "arn:aws:sns:us-west-2:123456789012:my-topic", //lintignore:AWSAT003,AWSAT005
+		},
+		"no template ARN error": {
+			f:           failing,
+			importID:    "arn:aws:sns:us-west-2:123456789012:my-topic", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			r := &wrappedResource{
+				bootstrapContext: bootstrapContext,
+				arn:              testCase.arn,
+			}
+			d := schema.TestResourceDataRaw(t, map[string]*schema.Schema{}, map[string]any{})
+			d.SetId(testCase.importID)
+
+			_, err := r.State(testCase.f)(ctx, d, nil)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("State err %t, want %t (%v)", got, want, err)
+			}
+
+			if err == nil {
+				if got, want := d.Id(), testCase.expectedID; got != want {
+					t.Errorf("ID = %q, want %q", got, want)
+				}
+			}
+		})
+	}
+}
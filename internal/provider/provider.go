@@ -74,6 +74,34 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					},
 				},
 			},
+			"distributed_lock": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to serialize changes to shared objects across provider processes.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"directory": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"distributed_lock.0.directory", "distributed_lock.0.dynamodb_table"},
+							Description:  "Local directory in which lock files are created.",
+						},
+						"dynamodb_table": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							ExactlyOneOf: []string{"distributed_lock.0.directory", "distributed_lock.0.dynamodb_table"},
+							Description:  "Name of the DynamoDB table in which leases are stored. The table's partition key must be a string named `LockID`.",
+						},
+						"lease_duration": {
+							Type:         schema.TypeString,
+							Optional:     true,
+							Description:  "Duration of lock leases, which are renewed while held. Valid time units are ns, us (or µs), ms, s, h, or m. Defaults to `30s`.",
+							ValidateFunc: validDistributedLockLeaseDuration,
+						},
+					},
+				},
+			},
 			"ec2_metadata_service_endpoint": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -86,6 +114,18 @@ func New(ctx context.Context) (*schema.Provider, error) {
 				Description: "Protocol to use with EC2 metadata service endpoint." +
 					"Valid values are `IPv4` and `IPv6`. Can also be configured using the `AWS_EC2_METADATA_SERVICE_ENDPOINT_MODE` environment variable.",
 			},
+			"endpoint_url": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"endpoint_url_template"},
+				Description:   "Base URL used for all service endpoints not otherwise configured. Useful for connecting to AWS compatible solutions such as LocalStack.",
+			},
+			"endpoint_url_template": {
+				Type:          schema.TypeString,
+				Optional:      true,
+				ConflictsWith: []string{"endpoint_url"},
+				Description:   "Template used to build service endpoint URLs not otherwise configured. The placeholders `{service}` and `{region}` are replaced with the hyphenated, lower-case AWS SDK service ID and AWS Region.",
+			},
 			"endpoints": endpointsSchema(),
 			"forbidden_account_ids": {
 				Type:          schema.TypeSet,
@@ -140,12 +180,33 @@ func New(ctx context.Context) (*schema.Provider, error) {
 					"being executed. If the API request still fails, an error is\n" +
 					"thrown.",
 			},
+			"memoize_data_sources": {
+				Type:        schema.TypeList,
+				Optional:    true,
+				MaxItems:    1,
+				Description: "Configuration block with settings to memoize data source reads. Reads of a data source with the same configuration return the same result for the lifetime of the provider instance.",
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"exclude": {
+							Type:        schema.TypeSet,
+							Optional:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Description: "Data source type names whose reads are never memoized.",
+						},
+					},
+				},
+			},
 			"no_proxy": {
 				Type:     schema.TypeString,
 				Optional: true,
 				Description: "Comma-separated list of hosts that should not use HTTP or HTTPS proxies. " +
 					"Can also be set using the `NO_PROXY` or `no_proxy` environment variables.",
 			},
+			"partitions_file": {
+				Type:        schema.TypeString,
+				Optional:    true,
+				Description: "Path to an AWS SDK endpoints.json-style file defining additional partitions and regions. Can also be configured using the `TF_AWS_PARTITIONS_FILE` environment variable.",
+			},
 			"profile": {
 				Type:     schema.TypeString,
 				Optional: true,
@@ -325,6 +386,9 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			ds := &wrappedDataSource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
+				typeName:         typeName,
+				schemaMap:        r.SchemaMap,
+				noMemoize:        v.NoMemoize,
 			}
 
 			if v := r.ReadWithoutTimeout; v != nil {
@@ -413,6 +477,7 @@ func New(ctx context.Context) (*schema.Provider, error) {
 			rs := &wrappedResource{
 				bootstrapContext: bootstrapContext,
 				interceptors:     interceptors,
+				arn:              v.ARN,
 			}
 
 			if v := r.CreateWithoutTimeout; v != nil {
@@ -480,9 +545,12 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		CustomCABundle:                 d.Get("custom_ca_bundle").(string),
 		EC2MetadataServiceEndpoint:     d.Get("ec2_metadata_service_endpoint").(string),
 		EC2MetadataServiceEndpointMode: d.Get("ec2_metadata_service_endpoint_mode").(string),
+		EndpointURL:                    d.Get("endpoint_url").(string),
+		EndpointURLTemplate:            d.Get("endpoint_url_template").(string),
 		Endpoints:                      make(map[string]string),
 		Insecure:                       d.Get("insecure").(bool),
 		MaxRetries:                     25, // Set default here, not in schema (muxing with v6 provider).
+		PartitionsFile:                 d.Get("partitions_file").(string),
 		Profile:                        d.Get("profile").(string),
 		Region:                         d.Get("region").(string),
 		S3UsePathStyle:                 d.Get("s3_use_path_style").(bool),
@@ -536,6 +604,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.DefaultTagsConfig = expandDefaultTags(ctx, v.([]interface{})[0].(map[string]interface{}))
 	}
 
+	if v, ok := d.GetOk("distributed_lock"); ok && len(v.([]interface{})) > 0 && v.([]interface{})[0] != nil {
+		config.DistributedLock = expandDistributedLock(ctx, v.([]interface{})[0].(map[string]interface{}))
+	}
+
 	v := d.Get("endpoints")
 	endpoints, dx := expandEndpoints(ctx, v.(*schema.Set).List())
 	diags = append(diags, dx...)
@@ -571,6 +643,10 @@ func configure(ctx context.Context, provider *schema.Provider, d *schema.Resourc
 		config.MaxRetries = v.(int)
 	}
 
+	if v, ok := d.GetOk("memoize_data_sources"); ok && len(v.([]interface{})) > 0 {
+		config.MemoizeDataSources = expandMemoizeDataSources(ctx, v.([]interface{})[0])
+	}
+
 	if v, ok := d.GetOk("shared_credentials_files"); ok && len(v.([]interface{})) > 0 {
 		config.SharedCredentialsFiles = flex.ExpandStringValueList(v.([]interface{}))
 	}
@@ -852,6 +928,29 @@ func expandDefaultTags(ctx context.Context, tfMap map[string]interface{}) *tftag
 	return defaultConfig
 }
 
+func expandDistributedLock(_ context.Context, tfMap map[string]interface{}) *conns.DistributedLockConfig {
+	if tfMap == nil {
+		return nil
+	}
+
+	distributedLockConfig := &conns.DistributedLockConfig{}
+
+	if v, ok := tfMap["directory"].(string); ok && v != "" {
+		distributedLockConfig.Directory = v
+	}
+
+	if v, ok := tfMap["dynamodb_table"].(string); ok && v != "" {
+		distributedLockConfig.DynamoDBTable = v
+	}
+
+	if v, ok := tfMap["lease_duration"].(string); ok && v != "" {
+		duration, _ := time.ParseDuration(v)
+		distributedLockConfig.LeaseDuration = duration
+	}
+
+	return distributedLockConfig
+}
+
 func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags.IgnoreConfig {
 	if tfMap == nil {
 		return nil
@@ -870,6 +969,19 @@ func expandIgnoreTags(ctx context.Context, tfMap map[string]interface{}) *tftags
 	return ignoreConfig
 }
 
+func expandMemoizeDataSources(_ context.Context, tfMap interface{}) *conns.MemoizeDataSourcesConfig {
+	memoizeConfig := &conns.MemoizeDataSourcesConfig{}
+
+	// An empty configuration block enables memoization of all data sources.
+	if tfMap, ok := tfMap.(map[string]interface{}); ok {
+		if v, ok := tfMap["exclude"].(*schema.Set); ok {
+			memoizeConfig.Exclude = flex.ExpandStringValueSet(v)
+		}
+	}
+
+	return memoizeConfig
+}
+
 func expandEndpoints(_ context.Context, tfList []interface{}) (map[string]string, diag.Diagnostics) {
 	var diags diag.Diagnostics
 
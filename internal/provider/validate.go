@@ -9,6 +9,7 @@ import (
 
 	"github.com/YakDriver/regexache"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 )
 
 // validAssumeRoleDuration validates a string can be parsed as a valid time.Duration
@@ -28,6 +29,23 @@ func validAssumeRoleDuration(v interface{}, k string) (ws []string, errors []err
 	return
 }
 
+// validDistributedLockLeaseDuration validates a string can be parsed as a valid time.Duration
+// and is at least the minimum distributed lock lease duration
+func validDistributedLockLeaseDuration(v interface{}, k string) (ws []string, errors []error) {
+	duration, err := time.ParseDuration(v.(string))
+
+	if err != nil {
+		errors = append(errors, fmt.Errorf("%q cannot be parsed as a duration: %w", k, err))
+		return
+	}
+
+	if duration < conns.MinDistributedLockLeaseDuration {
+		errors = append(errors, fmt.Errorf("duration %q must be at least %s", k, conns.MinDistributedLockLeaseDuration))
+	}
+
+	return
+}
+
 var validAssumeRoleSessionName = validation.All(
 	validation.StringLenBetween(2, 64),
 	validation.StringMatch(regexache.MustCompile(`[\w+=,.@\-]*`), ""),
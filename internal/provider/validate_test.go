@@ -73,3 +73,58 @@ func TestValidAssumeRoleDuration(t *testing.T) {
 		}
 	}
 }
+
+func TestValidDistributedLockLeaseDuration(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		val         interface{}
+		expectedErr *regexp.Regexp
+	}{
+		{
+			val:         "",
+			expectedErr: regexache.MustCompile(`cannot be parsed as a duration`),
+		},
+		{
+			val:         "30",
+			expectedErr: regexache.MustCompile(`cannot be parsed as a duration`),
+		},
+		{
+			val:         "1s",
+			expectedErr: regexache.MustCompile(`must be at least 5s`),
+		},
+		{
+			val: "5s",
+		},
+		{
+			val: "2m",
+		},
+	}
+
+	matchErr := func(errs []error, r *regexp.Regexp) bool {
+		// err must match one provided
+		for _, err := range errs {
+			if r.MatchString(err.Error()) {
+				return true
+			}
+		}
+
+		return false
+	}
+
+	for i, tc := range testCases {
+		_, errs := validDistributedLockLeaseDuration(tc.val, "test_property")
+
+		if len(errs) == 0 && tc.expectedErr == nil {
+			continue
+		}
+
+		if len(errs) != 0 && tc.expectedErr == nil {
+			t.Fatalf("expected test case %d to produce no errors, got %v", i, errs)
+		}
+
+		if !matchErr(errs, tc.expectedErr) {
+			t.Fatalf("expected test case %d to produce error matching \"%s\", got %v", i, tc.expectedErr, errs)
+		}
+	}
+}
@@ -0,0 +1,136 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package query implements client-side filtering of plural data source results using JMESPath expressions.
+//
+// The expression is evaluated against the list of results in the shape returned by the AWS API,
+// in the same way as the AWS CLI's --query option, and must return a subset of that list.
+// For example, "[?State.Name == 'running']" or "[?length(Tags[?Key == 'Environment']) > `0`]".
+// Timestamps are represented as Unix epoch seconds so that they can be compared numerically.
+package query
+
+import (
+	"fmt"
+	"reflect"
+	"time"
+
+	"github.com/jmespath/go-jmespath"
+)
+
+// Filter returns the items selected by the JMESPath expression, in the order returned by the expression.
+// An empty expression selects all items.
+func Filter[T any](expression string, items []T) ([]T, error) {
+	if expression == "" {
+		return items, nil
+	}
+
+	jp, err := jmespath.Compile(expression)
+	if err != nil {
+		return nil, fmt.Errorf("compiling query (%s): %w", expression, err)
+	}
+
+	data := make([]any, len(items))
+	for i, item := range items {
+		data[i] = value(reflect.ValueOf(item))
+	}
+
+	output, err := jp.Search(data)
+	if err != nil {
+		return nil, fmt.Errorf("evaluating query (%s): %w", expression, err)
+	}
+
+	if output == nil {
+		return []T{}, nil
+	}
+
+	selected, ok := output.([]any)
+	if !ok {
+		return nil, fmt.Errorf("query (%s) returned %T, want a list of results", expression, output)
+	}
+
+	// Map each selected element back to an unused item.
+	used := make([]bool, len(items))
+	result := make([]T, 0, len(selected))
+	for _, v := range selected {
+		i := -1
+		for j, d := range data {
+			if !used[j] && reflect.DeepEqual(v, d) {
+				i = j
+				break
+			}
+		}
+
+		if i == -1 {
+			return nil, fmt.Errorf("query (%s) must return a subset of the results", expression)
+		}
+
+		used[i] = true
+		result = append(result, items[i])
+	}
+
+	return result, nil
+}
+
+var timeType = reflect.TypeOf(time.Time{})
+
+// value returns the JMESPath data corresponding to the specified Go value.
+// Structs become objects keyed by exported field name, omitting nil fields.
+func value(v reflect.Value) any {
+	for v.Kind() == reflect.Pointer || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return nil
+		}
+		v = v.Elem()
+	}
+
+	if v.Type() == timeType {
+		return float64(v.Interface().(time.Time).Unix())
+	}
+
+	switch v.Kind() {
+	case reflect.Bool:
+		return v.Bool()
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return float64(v.Int())
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		return float64(v.Uint())
+	case reflect.Float32, reflect.Float64:
+		return v.Float()
+	case reflect.String:
+		return v.String()
+	case reflect.Slice, reflect.Array:
+		if v.Kind() == reflect.Slice && v.IsNil() {
+			return nil
+		}
+		s := make([]any, v.Len())
+		for i := range s {
+			s[i] = value(v.Index(i))
+		}
+		return s
+	case reflect.Map:
+		if v.IsNil() || v.Type().Key().Kind() != reflect.String {
+			return nil
+		}
+		m := make(map[string]any, v.Len())
+		iter := v.MapRange()
+		for iter.Next() {
+			m[iter.Key().String()] = value(iter.Value())
+		}
+		return m
+	case reflect.Struct:
+		t := v.Type()
+		m := make(map[string]any, t.NumField())
+		for i := 0; i < t.NumField(); i++ {
+			f := t.Field(i)
+			if !f.IsExported() {
+				continue
+			}
+			if fv := value(v.Field(i)); fv != nil {
+				m[f.Name] = fv
+			}
+		}
+		return m
+	}
+
+	return nil
+}
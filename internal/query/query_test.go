@@ -0,0 +1,173 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package query
+
+import (
+	"testing"
+	"time"
+
+	"github.com/google/go-cmp/cmp"
+)
+
+type testTag struct {
+	Key   *string
+	Value *string
+}
+
+type testItem struct {
+	Name      *string
+	Count     int32
+	Enabled   bool
+	Created   *time.Time
+	Tags      []testTag
+	Unset     *string
+	unexposed string
+}
+
+func TestFilter(t *testing.T) {
+	t.Parallel()
+
+	str := func(s string) *string { return &s }
+	tm := func(s string) *time.Time {
+		v, err := time.Parse(time.RFC3339, s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &v
+	}
+
+	items := []testItem{
+		{
+			Name:    str("one"),
+			Count:   1,
+			Enabled: true,
+			Created: tm("2024-01-01T00:00:00Z"),
+			Tags:    []testTag{{Key: str("Environment"), Value: str("production")}},
+		},
+		{
+			Name:      str("two"),
+			Count:     2,
+			Created:   tm("2024-06-01T00:00:00Z"),
+			unexposed: "x",
+		},
+		{
+			Name:    str("three"),
+			Count:   3,
+			Enabled: true,
+			Created: tm("2025-01-01T00:00:00Z"),
+			Tags:    []testTag{{Key: str("Environment"), Value: str("test")}},
+		},
+	}
+
+	testCases := map[string]struct {
+		expression    string
+		expected      []string
+		expectedError bool
+	}{
+		"empty": {
+			expected: []string{"one", "two", "three"},
+		},
+		"string equality": {
+			expression: "[?Name == 'two']",
+			expected:   []string{"two"},
+		},
+		"boolean": {
+			expression: "[?Enabled]",
+			expected:   []string{"one", "three"},
+		},
+		"number comparison": {
+			expression: "[?Count > `1`]",
+			expected:   []string{"two", "three"},
+		},
+		"timestamp comparison": {
+			expression: "[?Created < `1717200000`]",
+			expected:   []string{"one"},
+		},
+		"nested list": {
+			expression: "[?Tags[?Key == 'Environment' && Value == 'production']]",
+			expected:   []string{"one"},
+		},
+		"nil field omitted": {
+			expression: "[?Unset]",
+			expected:   []string{},
+		},
+		"no match": {
+			expression: "[?Name == 'four']",
+			expected:   []string{},
+		},
+		"reorder": {
+			expression: "reverse(@)",
+			expected:   []string{"three", "two", "one"},
+		},
+		"slice": {
+			expression: "[:2]",
+			expected:   []string{"one", "two"},
+		},
+		"projection": {
+			expression:    "[].Name",
+			expectedError: true,
+		},
+		"not a list": {
+			expression:    "length(@)",
+			expectedError: true,
+		},
+		"invalid": {
+			expression:    "[?Name ==",
+			expectedError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			output, err := Filter(testCase.expression, items)
+
+			if got, want := err != nil, testCase.expectedError; got != want {
+				t.Fatalf("Filter(%q) err %t, want %t (%v)", testCase.expression, got, want, err)
+			}
+
+			if err != nil {
+				return
+			}
+
+			got := make([]string, 0, len(output))
+			for _, v := range output {
+				got = append(got, *v.Name)
+			}
+
+			if diff := cmp.Diff(got, testCase.expected); diff != "" {
+				t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+			}
+		})
+	}
+}
+
+func TestValidExpression(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		value         any
+		expectedError bool
+	}{
+		"empty":      {value: ""},
+		"valid":      {value: "[?State.Name == 'running']"},
+		"invalid":    {value: "[?State.Name ==", expectedError: true},
+		"not string": {value: 1, expectedError: true},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			_, errs := validExpression(testCase.value, "query")
+
+			if got, want := len(errs) > 0, testCase.expectedError; got != want {
+				t.Errorf("validExpression(%v) errors %t, want %t (%v)", testCase.value, got, want, errs)
+			}
+		})
+	}
+}
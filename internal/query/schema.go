@@ -0,0 +1,47 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package query
+
+import (
+	"fmt"
+
+	datasourceschema "github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	fwvalidators "github.com/hashicorp/terraform-provider-aws/internal/framework/validators"
+	"github.com/jmespath/go-jmespath"
+)
+
+// Schema returns the Plugin SDK schema for a plural data source's optional query argument.
+func Schema() *schema.Schema {
+	return &schema.Schema{
+		Type:         schema.TypeString,
+		Optional:     true,
+		ValidateFunc: validExpression,
+	}
+}
+
+// Attribute returns the Plugin Framework schema for a plural data source's optional query attribute.
+func Attribute() datasourceschema.StringAttribute {
+	return datasourceschema.StringAttribute{
+		Optional: true,
+		Validators: []validator.String{
+			fwvalidators.JMESPath(),
+		},
+	}
+}
+
+func validExpression(v any, k string) (ws []string, errors []error) {
+	value, ok := v.(string)
+	if !ok {
+		errors = append(errors, fmt.Errorf("expected type of %s to be string", k))
+		return
+	}
+
+	if _, err := jmespath.Compile(value); value != "" && err != nil {
+		errors = append(errors, fmt.Errorf("%q contains an invalid JMESPath expression: %w", k, err))
+	}
+
+	return
+}
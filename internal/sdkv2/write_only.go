@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package sdkv2
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/go-cty/cty"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+// Write-only attributes hold secret material, e.g. passwords, that must never be persisted in plan or state.
+// A write-only attribute is paired with a version attribute, changes to which trigger an update.
+// The write-only value is read from raw configuration during Create and Update.
+
+// SuppressWriteOnly provides custom difference suppression for write-only attributes.
+// All differences are suppressed so that the configured value is never written to plan or state.
+func SuppressWriteOnly(k, old, new string, d *schema.ResourceData) bool {
+	return true
+}
+
+// GetWriteOnlyStringValue returns the value of the specified top-level write-only string attribute from raw configuration.
+// An empty string is returned if the attribute is not configured.
+func GetWriteOnlyStringValue(d *schema.ResourceData, key string) (string, error) {
+	v, err := cty.GetAttrPath(key).Apply(d.GetRawConfig())
+
+	if err != nil {
+		return "", fmt.Errorf("reading write-only attribute (%s): %w", key, err)
+	}
+
+	if v.IsNull() {
+		return "", nil
+	}
+
+	if !v.IsKnown() {
+		return "", fmt.Errorf("write-only attribute (%s) value is unknown", key)
+	}
+
+	if !v.Type().Equals(cty.String) {
+		return "", fmt.Errorf("write-only attribute (%s) has unexpected type: %s", key, v.Type().FriendlyName())
+	}
+
+	return v.AsString(), nil
+}
@@ -23,6 +23,7 @@ func TestAccAccessAnalyzer_serial(t *testing.T) {
 			names.AttrConfiguration: testAccAnalyzer_configuration,
 			"disappears":            testAccAnalyzer_disappears,
 			names.AttrTags:          testAccAccessAnalyzerAnalyzer_tagsSerial,
+			"generated":             testAccAccessAnalyzerAnalyzer_generatedSerial,
 			"Type_Organization":     testAccAnalyzer_Type_Organization,
 		},
 		"ArchiveRule": {
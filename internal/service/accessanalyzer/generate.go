@@ -4,6 +4,7 @@
 //go:generate go run ../../generate/tags/main.go -AWSSDKVersion=2 -ListTags -ServiceTagsMap -UpdateTags -KVTValues -SkipTypesImp
 //go:generate go run ../../generate/servicepackage/main.go
 //go:generate go run ../../generate/tagstests/main.go
+//go:generate go run ../../generate/resourcetests/main.go
 // ONLY generate directives and package declaration! Do not add anything else to this file.
 
 package accessanalyzer
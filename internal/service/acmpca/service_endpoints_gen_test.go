@@ -59,13 +59,16 @@ const (
 	baseEnvvarEndpoint        = "https://base-envvar.endpoint.test/"
 	serviceConfigFileEndpoint = "https://service-configfile.endpoint.test/"
 	baseConfigFileEndpoint    = "https://base-configfile.endpoint.test/"
+	baseURLConfigEndpoint     = "https://base-url-config.endpoint.test/"
+	baseURLTemplateConfig     = "https://{service}.{region}.base-url-template-config.endpoint.test/"
 )
 
 const (
-	packageName = "acmpca"
-	awsEnvVar   = "AWS_ENDPOINT_URL_ACM_PCA"
-	baseEnvVar  = "AWS_ENDPOINT_URL"
-	configParam = "acm_pca"
+	packageName  = "acmpca"
+	awsEnvVar    = "AWS_ENDPOINT_URL_ACM_PCA"
+	urlServiceID = "acm-pca"
+	baseEnvVar   = "AWS_ENDPOINT_URL"
+	configParam  = "acm_pca"
 )
 
 func TestEndpointConfiguration(t *testing.T) { //nolint:paralleltest // uses t.Setenv
@@ -118,6 +121,22 @@ func TestEndpointConfiguration(t *testing.T) { //nolint:paralleltest // uses t.S
 			expected: expectPackageNameConfigEndpoint(),
 		},
 
+		"package name endpoint config overrides base URL config": {
+			with: []setupFunc{
+				withPackageNameEndpointInConfig,
+				withBaseURLEndpointInConfig,
+			},
+			expected: expectPackageNameConfigEndpoint(),
+		},
+
+		"package name endpoint config overrides base URL template config": {
+			with: []setupFunc{
+				withPackageNameEndpointInConfig,
+				withBaseURLTemplateEndpointInConfig,
+			},
+			expected: expectPackageNameConfigEndpoint(),
+		},
+
 		// Service endpoint in AWS envvar
 
 		"service aws envvar": {
@@ -151,6 +170,22 @@ func TestEndpointConfiguration(t *testing.T) { //nolint:paralleltest // uses t.S
 			expected: expectAwsEnvVarEndpoint(),
 		},
 
+		"service aws envvar overrides base URL config": {
+			with: []setupFunc{
+				withAwsEnvVar,
+				withBaseURLEndpointInConfig,
+			},
+			expected: expectAwsEnvVarEndpoint(),
+		},
+
+		"service aws envvar overrides base URL template config": {
+			with: []setupFunc{
+				withAwsEnvVar,
+				withBaseURLTemplateEndpointInConfig,
+			},
+			expected: expectAwsEnvVarEndpoint(),
+		},
+
 		// Base endpoint in envvar
 
 		"base endpoint envvar": {
@@ -193,6 +228,56 @@ func TestEndpointConfiguration(t *testing.T) { //nolint:paralleltest // uses t.S
 			expected: expectServiceConfigFileEndpoint(),
 		},
 
+		"service config file overrides base URL config": {
+			with: []setupFunc{
+				withServiceEndpointInConfigFile,
+				withBaseURLEndpointInConfig,
+			},
+			expected: expectServiceConfigFileEndpoint(),
+		},
+
+		// Base endpoint URL on Config
+
+		"base URL config": {
+			with: []setupFunc{
+				withBaseURLEndpointInConfig,
+			},
+			expected: expectBaseURLConfigEndpoint(),
+		},
+
+		"base URL config overrides base envvar": {
+			with: []setupFunc{
+				withBaseURLEndpointInConfig,
+				withBaseEnvVar,
+			},
+			expected: expectBaseURLConfigEndpoint(),
+		},
+
+		"base URL config overrides base config file": {
+			with: []setupFunc{
+				withBaseURLEndpointInConfig,
+				withBaseEndpointInConfigFile,
+			},
+			expected: expectBaseURLConfigEndpoint(),
+		},
+
+		// Base endpoint URL template on Config
+
+		"base URL template config": {
+			with: []setupFunc{
+				withBaseURLTemplateEndpointInConfig,
+			},
+			expected: expectBaseURLTemplateConfigEndpoint(region),
+		},
+
+		"base URL template config overrides base envvar": {
+			with: []setupFunc{
+				withBaseURLTemplateEndpointInConfig,
+				withBaseEnvVar,
+			},
+			expected: expectBaseURLTemplateConfigEndpoint(region),
+		},
+
 		// Base endpoint in config file
 
 		"base endpoint config file": {
@@ -283,6 +368,14 @@ func withBaseEndpointInConfigFile(setup *caseSetup) {
 	setup.configFile.baseUrl = baseConfigFileEndpoint
 }
 
+func withBaseURLEndpointInConfig(setup *caseSetup) {
+	setup.config[names.AttrEndpointURL] = baseURLConfigEndpoint
+}
+
+func withBaseURLTemplateEndpointInConfig(setup *caseSetup) {
+	setup.config[names.AttrEndpointURLTemplate] = baseURLTemplateConfig
+}
+
 func expectDefaultEndpoint(region string) caseExpectations {
 	return caseExpectations{
 		endpoint: defaultEndpoint(region),
@@ -319,6 +412,18 @@ func expectBaseConfigFileEndpoint() caseExpectations {
 	}
 }
 
+func expectBaseURLConfigEndpoint() caseExpectations {
+	return caseExpectations{
+		endpoint: baseURLConfigEndpoint,
+	}
+}
+
+func expectBaseURLTemplateConfigEndpoint(region string) caseExpectations {
+	return caseExpectations{
+		endpoint: strings.NewReplacer("{service}", urlServiceID, "{region}", region).Replace(baseURLTemplateConfig),
+	}
+}
+
 func testEndpointCase(t *testing.T, region string, testcase endpointTestCase, callF callFunc) {
 	t.Helper()
 
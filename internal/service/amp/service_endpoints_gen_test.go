@@ -60,16 +60,19 @@ const (
 	baseEnvvarEndpoint        = "https://base-envvar.endpoint.test/"
 	serviceConfigFileEndpoint = "https://service-configfile.endpoint.test/"
 	baseConfigFileEndpoint    = "https://base-configfile.endpoint.test/"
+	baseURLConfigEndpoint     = "https://base-url-config.endpoint.test/"
+	baseURLTemplateConfig     = "https://{service}.{region}.base-url-template-config.endpoint.test/"
 
 	aliasName0ConfigEndpoint = "https://aliasname0-config.endpoint.test/"
 	aliasName1ConfigEndpoint = "https://aliasname1-config.endpoint.test/"
 )
 
 const (
-	packageName = "amp"
-	awsEnvVar   = "AWS_ENDPOINT_URL_AMP"
-	baseEnvVar  = "AWS_ENDPOINT_URL"
-	configParam = "amp"
+	packageName  = "amp"
+	awsEnvVar    = "AWS_ENDPOINT_URL_AMP"
+	urlServiceID = "amp"
+	baseEnvVar   = "AWS_ENDPOINT_URL"
+	configParam  = "amp"
 
 	aliasName0 = "prometheus"
 	aliasName1 = "prometheusservice"
@@ -141,6 +144,22 @@ func TestEndpointConfiguration(t *testing.T) { //nolint:paralleltest // uses t.S
 			expected: expectPackageNameConfigEndpoint(),
 		},
 
+		"package name endpoint config overrides base URL config": {
+			with: []setupFunc{
+				withPackageNameEndpointInConfig,
+				withBaseURLEndpointInConfig,
+			},
+			expected: expectPackageNameConfigEndpoint(),
+		},
+
+		"package name endpoint config overrides base URL template config": {
+			with: []setupFunc{
+				withPackageNameEndpointInConfig,
+				withBaseURLTemplateEndpointInConfig,
+			},
+			expected: expectPackageNameConfigEndpoint(),
+		},
+
 		// Alias name 0 endpoint on Config
 
 		"alias name 0 endpoint config": {
@@ -264,6 +283,22 @@ func TestEndpointConfiguration(t *testing.T) { //nolint:paralleltest // uses t.S
 			expected: expectAwsEnvVarEndpoint(),
 		},
 
+		"service aws envvar overrides base URL config": {
+			with: []setupFunc{
+				withAwsEnvVar,
+				withBaseURLEndpointInConfig,
+			},
+			expected: expectAwsEnvVarEndpoint(),
+		},
+
+		"service aws envvar overrides base URL template config": {
+			with: []setupFunc{
+				withAwsEnvVar,
+				withBaseURLTemplateEndpointInConfig,
+			},
+			expected: expectAwsEnvVarEndpoint(),
+		},
+
 		// Base endpoint in envvar
 
 		"base endpoint envvar": {
@@ -306,6 +341,56 @@ func TestEndpointConfiguration(t *testing.T) { //nolint:paralleltest // uses t.S
 			expected: expectServiceConfigFileEndpoint(),
 		},
 
+		"service config file overrides base URL config": {
+			with: []setupFunc{
+				withServiceEndpointInConfigFile,
+				withBaseURLEndpointInConfig,
+			},
+			expected: expectServiceConfigFileEndpoint(),
+		},
+
+		// Base endpoint URL on Config
+
+		"base URL config": {
+			with: []setupFunc{
+				withBaseURLEndpointInConfig,
+			},
+			expected: expectBaseURLConfigEndpoint(),
+		},
+
+		"base URL config overrides base envvar": {
+			with: []setupFunc{
+				withBaseURLEndpointInConfig,
+				withBaseEnvVar,
+			},
+			expected: expectBaseURLConfigEndpoint(),
+		},
+
+		"base URL config overrides base config file": {
+			with: []setupFunc{
+				withBaseURLEndpointInConfig,
+				withBaseEndpointInConfigFile,
+			},
+			expected: expectBaseURLConfigEndpoint(),
+		},
+
+		// Base endpoint URL template on Config
+
+		"base URL template config": {
+			with: []setupFunc{
+				withBaseURLTemplateEndpointInConfig,
+			},
+			expected: expectBaseURLTemplateConfigEndpoint(region),
+		},
+
+		"base URL template config overrides base envvar": {
+			with: []setupFunc{
+				withBaseURLTemplateEndpointInConfig,
+				withBaseEnvVar,
+			},
+			expected: expectBaseURLTemplateConfigEndpoint(region),
+		},
+
 		// Base endpoint in config file
 
 		"base endpoint config file": {
@@ -426,6 +511,14 @@ func withBaseEndpointInConfigFile(setup *caseSetup) {
 	setup.configFile.baseUrl = baseConfigFileEndpoint
 }
 
+func withBaseURLEndpointInConfig(setup *caseSetup) {
+	setup.config[names.AttrEndpointURL] = baseURLConfigEndpoint
+}
+
+func withBaseURLTemplateEndpointInConfig(setup *caseSetup) {
+	setup.config[names.AttrEndpointURLTemplate] = baseURLTemplateConfig
+}
+
 func expectDefaultEndpoint(region string) caseExpectations {
 	return caseExpectations{
 		endpoint: defaultEndpoint(region),
@@ -474,6 +567,18 @@ func expectBaseConfigFileEndpoint() caseExpectations {
 	}
 }
 
+func expectBaseURLConfigEndpoint() caseExpectations {
+	return caseExpectations{
+		endpoint: baseURLConfigEndpoint,
+	}
+}
+
+func expectBaseURLTemplateConfigEndpoint(region string) caseExpectations {
+	return caseExpectations{
+		endpoint: strings.NewReplacer("{service}", urlServiceID, "{region}", region).Replace(baseURLTemplateConfig),
+	}
+}
+
 func testEndpointCase(t *testing.T, region string, testcase endpointTestCase, callF callFunc) {
 	t.Helper()
 
@@ -53,3 +53,31 @@ func reportSettingTemplate_Values() []string {
 		reportSettingTemplateRestoreJobReport,
 	}
 }
+
+const (
+	restoreTestingProtectedResourceTypeAurora     = "Aurora"
+	restoreTestingProtectedResourceTypeDocumentDB = "DocumentDB"
+	restoreTestingProtectedResourceTypeDynamoDB   = "DynamoDB"
+	restoreTestingProtectedResourceTypeEBS        = "EBS"
+	restoreTestingProtectedResourceTypeEC2        = "EC2"
+	restoreTestingProtectedResourceTypeEFS        = "EFS"
+	restoreTestingProtectedResourceTypeFSx        = "FSx"
+	restoreTestingProtectedResourceTypeNeptune    = "Neptune"
+	restoreTestingProtectedResourceTypeRDS        = "RDS"
+	restoreTestingProtectedResourceTypeS3         = "S3"
+)
+
+func restoreTestingProtectedResourceType_Values() []string {
+	return []string{
+		restoreTestingProtectedResourceTypeAurora,
+		restoreTestingProtectedResourceTypeDocumentDB,
+		restoreTestingProtectedResourceTypeDynamoDB,
+		restoreTestingProtectedResourceTypeEBS,
+		restoreTestingProtectedResourceTypeEC2,
+		restoreTestingProtectedResourceTypeEFS,
+		restoreTestingProtectedResourceTypeFSx,
+		restoreTestingProtectedResourceTypeNeptune,
+		restoreTestingProtectedResourceTypeRDS,
+		restoreTestingProtectedResourceTypeS3,
+	}
+}
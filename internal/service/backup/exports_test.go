@@ -0,0 +1,15 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package backup
+
+// Exports for use in tests only.
+var (
+	ResourceLogicallyAirGappedVault = newLogicallyAirGappedVaultResource
+	ResourceRestoreTestingPlan      = newRestoreTestingPlanResource
+	ResourceRestoreTestingSelection = newRestoreTestingSelectionResource
+
+	FindLogicallyAirGappedVaultByName       = findLogicallyAirGappedVaultByName
+	FindRestoreTestingPlanByName            = findRestoreTestingPlanByName
+	FindRestoreTestingSelectionByTwoPartKey = findRestoreTestingSelectionByTwoPartKey
+)
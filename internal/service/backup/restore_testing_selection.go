@@ -0,0 +1,371 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package backup
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/backup"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/backup/types"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Restore Testing Selection")
+func newRestoreTestingSelectionResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &restoreTestingSelectionResource{}
+
+	return r, nil
+}
+
+type restoreTestingSelectionResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*restoreTestingSelectionResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_backup_restore_testing_selection"
+}
+
+func (r *restoreTestingSelectionResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	keyValueBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[keyValueModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				names.AttrKey: schema.StringAttribute{
+					Required: true,
+				},
+				names.AttrValue: schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrIAMRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9A-Za-z_]{1,50}$`), "must consist of 1 to 50 alphanumeric characters and underscores"),
+				},
+			},
+			"protected_resource_arns": schema.SetAttribute{
+				CustomType:  fwtypes.SetOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"protected_resource_type": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(restoreTestingProtectedResourceType_Values()...),
+				},
+			},
+			"restore_metadata_overrides": schema.MapAttribute{
+				CustomType:  fwtypes.MapOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+				Computed:    true,
+				Sensitive:   true,
+				PlanModifiers: []planmodifier.Map{
+					mapplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"restore_testing_plan_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"validation_window_hours": schema.Int64Attribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(0, 168),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"protected_resource_conditions": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[protectedResourceConditionsModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"string_equals":     keyValueBlock,
+						"string_not_equals": keyValueBlock,
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *restoreTestingSelectionResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data restoreTestingSelectionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BackupClient(ctx)
+
+	restoreTestingSelection := &awstypes.RestoreTestingSelectionForCreate{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, restoreTestingSelection)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &backup.CreateRestoreTestingSelectionInput{
+		CreatorRequestId:        aws.String(id.UniqueId()),
+		RestoreTestingPlanName:  fwflex.StringFromFramework(ctx, data.RestoreTestingPlanName),
+		RestoreTestingSelection: restoreTestingSelection,
+	}
+
+	_, err := conn.CreateRestoreTestingSelection(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Backup Restore Testing Selection (%s)", data.RestoreTestingSelectionName.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	output, err := findRestoreTestingSelectionByTwoPartKey(ctx, conn, data.RestoreTestingPlanName.ValueString(), data.RestoreTestingSelectionName.ValueString())
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Backup Restore Testing Selection (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *restoreTestingSelectionResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data restoreTestingSelectionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().BackupClient(ctx)
+
+	output, err := findRestoreTestingSelectionByTwoPartKey(ctx, conn, data.RestoreTestingPlanName.ValueString(), data.RestoreTestingSelectionName.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Backup Restore Testing Selection (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *restoreTestingSelectionResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new restoreTestingSelectionResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BackupClient(ctx)
+
+	restoreTestingSelection := &awstypes.RestoreTestingSelectionForUpdate{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, restoreTestingSelection)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input := &backup.UpdateRestoreTestingSelectionInput{
+		RestoreTestingPlanName:      fwflex.StringFromFramework(ctx, new.RestoreTestingPlanName),
+		RestoreTestingSelection:     restoreTestingSelection,
+		RestoreTestingSelectionName: fwflex.StringFromFramework(ctx, new.RestoreTestingSelectionName),
+	}
+
+	_, err := conn.UpdateRestoreTestingSelection(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Backup Restore Testing Selection (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	output, err := findRestoreTestingSelectionByTwoPartKey(ctx, conn, new.RestoreTestingPlanName.ValueString(), new.RestoreTestingSelectionName.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Backup Restore Testing Selection (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *restoreTestingSelectionResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data restoreTestingSelectionResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BackupClient(ctx)
+
+	_, err := conn.DeleteRestoreTestingSelection(ctx, &backup.DeleteRestoreTestingSelectionInput{
+		RestoreTestingPlanName:      fwflex.StringFromFramework(ctx, data.RestoreTestingPlanName),
+		RestoreTestingSelectionName: fwflex.StringFromFramework(ctx, data.RestoreTestingSelectionName),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Backup Restore Testing Selection (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findRestoreTestingSelectionByTwoPartKey(ctx context.Context, conn *backup.Client, restoreTestingPlanName, restoreTestingSelectionName string) (*awstypes.RestoreTestingSelectionForGet, error) {
+	input := &backup.GetRestoreTestingSelectionInput{
+		RestoreTestingPlanName:      aws.String(restoreTestingPlanName),
+		RestoreTestingSelectionName: aws.String(restoreTestingSelectionName),
+	}
+
+	output, err := conn.GetRestoreTestingSelection(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.RestoreTestingSelection == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.RestoreTestingSelection, nil
+}
+
+type restoreTestingSelectionResourceModel struct {
+	IAMRoleARN                  fwtypes.ARN                                                       `tfsdk:"iam_role_arn"`
+	ID                          types.String                                                      `tfsdk:"id"`
+	ProtectedResourceARNs       fwtypes.SetValueOf[types.String]                                  `tfsdk:"protected_resource_arns"`
+	ProtectedResourceConditions fwtypes.ListNestedObjectValueOf[protectedResourceConditionsModel] `tfsdk:"protected_resource_conditions"`
+	ProtectedResourceType       types.String                                                      `tfsdk:"protected_resource_type"`
+	RestoreMetadataOverrides    fwtypes.MapValueOf[types.String]                                  `tfsdk:"restore_metadata_overrides"`
+	RestoreTestingPlanName      types.String                                                      `tfsdk:"restore_testing_plan_name"`
+	RestoreTestingSelectionName types.String                                                      `tfsdk:"name"`
+	ValidationWindowHours       types.Int64                                                       `tfsdk:"validation_window_hours"`
+}
+
+const (
+	restoreTestingSelectionResourceIDPartCount = 2
+)
+
+func (data *restoreTestingSelectionResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), restoreTestingSelectionResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	data.RestoreTestingPlanName = types.StringValue(parts[0])
+	data.RestoreTestingSelectionName = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (data *restoreTestingSelectionResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.RestoreTestingPlanName.ValueString(), data.RestoreTestingSelectionName.ValueString()}, restoreTestingSelectionResourceIDPartCount, false)))
+}
+
+type protectedResourceConditionsModel struct {
+	StringEquals    fwtypes.ListNestedObjectValueOf[keyValueModel] `tfsdk:"string_equals"`
+	StringNotEquals fwtypes.ListNestedObjectValueOf[keyValueModel] `tfsdk:"string_not_equals"`
+}
+
+type keyValueModel struct {
+	Key   types.String `tfsdk:"key"`
+	Value types.String `tfsdk:"value"`
+}
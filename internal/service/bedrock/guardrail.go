@@ -0,0 +1,803 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrock"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrock/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/float64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/setvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+const (
+	guardrailDraftVersion = "DRAFT"
+)
+
+// @FrameworkResource(name="Guardrail")
+// @Tags(identifierAttribute="guardrail_arn")
+func newGuardrailResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &guardrailResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+	r.SetDefaultDeleteTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type guardrailResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*guardrailResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_bedrock_guardrail"
+}
+
+func (r *guardrailResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"blocked_input_messaging": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 500),
+				},
+			},
+			"blocked_outputs_messaging": schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 500),
+				},
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			"guardrail_arn": framework.ARNAttributeComputedOnly(),
+			"guardrail_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrKMSKeyARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 50),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.GuardrailStatus](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			names.AttrVersion: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"content_policy_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[guardrailContentPolicyConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"filters_config": schema.SetNestedBlock{
+							CustomType: fwtypes.NewSetNestedObjectTypeOf[guardrailContentFilterConfigModel](ctx),
+							Validators: []validator.Set{
+								setvalidator.IsRequired(),
+								setvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"input_strength": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.GuardrailFilterStrength](),
+										Required:   true,
+									},
+									"output_strength": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.GuardrailFilterStrength](),
+										Required:   true,
+									},
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.GuardrailContentFilterType](),
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"contextual_grounding_policy_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[guardrailContextualGroundingPolicyConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"filters_config": schema.SetNestedBlock{
+							CustomType: fwtypes.NewSetNestedObjectTypeOf[guardrailContextualGroundingFilterConfigModel](ctx),
+							Validators: []validator.Set{
+								setvalidator.IsRequired(),
+								setvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"threshold": schema.Float64Attribute{
+										Required: true,
+										Validators: []validator.Float64{
+											float64validator.AtLeast(0),
+										},
+									},
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.GuardrailContextualGroundingFilterType](),
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"sensitive_information_policy_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[guardrailSensitiveInformationPolicyConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"pii_entities_config": schema.SetNestedBlock{
+							CustomType: fwtypes.NewSetNestedObjectTypeOf[guardrailPIIEntityConfigModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrAction: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.GuardrailSensitiveInformationAction](),
+										Required:   true,
+									},
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.GuardrailPiiEntityType](),
+										Required:   true,
+									},
+								},
+							},
+						},
+						"regexes_config": schema.SetNestedBlock{
+							CustomType: fwtypes.NewSetNestedObjectTypeOf[guardrailRegexConfigModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrAction: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.GuardrailSensitiveInformationAction](),
+										Required:   true,
+									},
+									names.AttrDescription: schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.LengthAtMost(1000),
+										},
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 100),
+										},
+									},
+									"pattern": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 500),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+			"topic_policy_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[guardrailTopicPolicyConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"topics_config": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[guardrailTopicConfigModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"definition": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 200),
+										},
+									},
+									"examples": schema.ListAttribute{
+										CustomType:  fwtypes.ListOfStringType,
+										ElementType: types.StringType,
+										Optional:    true,
+									},
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 100),
+										},
+									},
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.GuardrailTopicType](),
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"word_policy_config": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[guardrailWordPolicyConfigModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"managed_word_lists_config": schema.SetNestedBlock{
+							CustomType: fwtypes.NewSetNestedObjectTypeOf[guardrailManagedWordsConfigModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.GuardrailManagedWordsType](),
+										Required:   true,
+									},
+								},
+							},
+						},
+						"words_config": schema.SetNestedBlock{
+							CustomType: fwtypes.NewSetNestedObjectTypeOf[guardrailWordConfigModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									"text": schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 100),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *guardrailResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data guardrailResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &bedrock.CreateGuardrailInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientRequestToken = aws.String(id.UniqueId())
+	input.KmsKeyId = fwflex.StringFromFramework(ctx, data.KMSKeyARN)
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateGuardrail(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Guardrail (%s)", name), err.Error())
+
+		return
+	}
+
+	data.GuardrailID = fwflex.StringToFramework(ctx, output.GuardrailId)
+	data.setID()
+
+	guardrail, err := waitGuardrailCreated(ctx, conn, data.GuardrailID.ValueString(), r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Guardrail (%s) create", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.CreatedAt = fwflex.TimeToFramework(ctx, guardrail.CreatedAt)
+	data.GuardrailARN = fwflex.StringToFramework(ctx, guardrail.GuardrailArn)
+	data.Status = fwtypes.StringEnumValue(guardrail.Status)
+	data.Version = fwflex.StringToFramework(ctx, guardrail.Version)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *guardrailResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data guardrailResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	output, err := findGuardrailByTwoPartKey(ctx, conn, data.GuardrailID.ValueString(), guardrailDraftVersion)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Guardrail (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(data.flatten(ctx, output)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *guardrailResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new guardrailResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	if !new.BlockedInputMessaging.Equal(old.BlockedInputMessaging) ||
+		!new.BlockedOutputsMessaging.Equal(old.BlockedOutputsMessaging) ||
+		!new.ContentPolicyConfig.Equal(old.ContentPolicyConfig) ||
+		!new.ContextualGroundingPolicyConfig.Equal(old.ContextualGroundingPolicyConfig) ||
+		!new.Description.Equal(old.Description) ||
+		!new.KMSKeyARN.Equal(old.KMSKeyARN) ||
+		!new.Name.Equal(old.Name) ||
+		!new.SensitiveInformationPolicyConfig.Equal(old.SensitiveInformationPolicyConfig) ||
+		!new.TopicPolicyConfig.Equal(old.TopicPolicyConfig) ||
+		!new.WordPolicyConfig.Equal(old.WordPolicyConfig) {
+		input := &bedrock.UpdateGuardrailInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// Additional fields.
+		input.GuardrailIdentifier = fwflex.StringFromFramework(ctx, new.GuardrailID)
+		input.KmsKeyId = fwflex.StringFromFramework(ctx, new.KMSKeyARN)
+
+		_, err := conn.UpdateGuardrail(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Guardrail (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		guardrail, err := waitGuardrailUpdated(ctx, conn, new.GuardrailID.ValueString(), r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Guardrail (%s) update", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.Status = fwtypes.StringEnumValue(guardrail.Status)
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *guardrailResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data guardrailResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockClient(ctx)
+
+	_, err := conn.DeleteGuardrail(ctx, &bedrock.DeleteGuardrailInput{
+		GuardrailIdentifier: fwflex.StringFromFramework(ctx, data.GuardrailID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Guardrail (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	if _, err := waitGuardrailDeleted(ctx, conn, data.GuardrailID.ValueString(), guardrailDraftVersion, r.DeleteTimeout(ctx, data.Timeouts)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Bedrock Guardrail (%s) delete", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *guardrailResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func findGuardrailByTwoPartKey(ctx context.Context, conn *bedrock.Client, id, version string) (*bedrock.GetGuardrailOutput, error) {
+	input := &bedrock.GetGuardrailInput{
+		GuardrailIdentifier: aws.String(id),
+		GuardrailVersion:    aws.String(version),
+	}
+
+	output, err := conn.GetGuardrail(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusGuardrail(ctx context.Context, conn *bedrock.Client, id, version string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findGuardrailByTwoPartKey(ctx, conn, id, version)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitGuardrailCreated(ctx context.Context, conn *bedrock.Client, id string, timeout time.Duration) (*bedrock.GetGuardrailOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.GuardrailStatusCreating),
+		Target:  enum.Slice(awstypes.GuardrailStatusReady),
+		Refresh: statusGuardrail(ctx, conn, id, guardrailDraftVersion),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetGuardrailOutput); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(output.StatusReasons, "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGuardrailUpdated(ctx context.Context, conn *bedrock.Client, id string, timeout time.Duration) (*bedrock.GetGuardrailOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.GuardrailStatusUpdating),
+		Target:  enum.Slice(awstypes.GuardrailStatusReady),
+		Refresh: statusGuardrail(ctx, conn, id, guardrailDraftVersion),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetGuardrailOutput); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(output.StatusReasons, "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+func waitGuardrailDeleted(ctx context.Context, conn *bedrock.Client, id, version string, timeout time.Duration) (*bedrock.GetGuardrailOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.GuardrailStatusDeleting),
+		Target:  []string{},
+		Refresh: statusGuardrail(ctx, conn, id, version),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrock.GetGuardrailOutput); ok {
+		tfresource.SetLastError(err, errors.New(strings.Join(output.StatusReasons, "; ")))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+type guardrailResourceModel struct {
+	BlockedInputMessaging            types.String                                                                    `tfsdk:"blocked_input_messaging"`
+	BlockedOutputsMessaging          types.String                                                                    `tfsdk:"blocked_outputs_messaging"`
+	ContentPolicyConfig              fwtypes.ListNestedObjectValueOf[guardrailContentPolicyConfigModel]              `tfsdk:"content_policy_config"`
+	ContextualGroundingPolicyConfig  fwtypes.ListNestedObjectValueOf[guardrailContextualGroundingPolicyConfigModel]  `tfsdk:"contextual_grounding_policy_config"`
+	CreatedAt                        timetypes.RFC3339                                                               `tfsdk:"created_at"`
+	Description                      types.String                                                                    `tfsdk:"description"`
+	GuardrailARN                     types.String                                                                    `tfsdk:"guardrail_arn"`
+	GuardrailID                      types.String                                                                    `tfsdk:"guardrail_id"`
+	ID                               types.String                                                                    `tfsdk:"id"`
+	KMSKeyARN                        fwtypes.ARN                                                                     `tfsdk:"kms_key_arn"`
+	Name                             types.String                                                                    `tfsdk:"name"`
+	SensitiveInformationPolicyConfig fwtypes.ListNestedObjectValueOf[guardrailSensitiveInformationPolicyConfigModel] `tfsdk:"sensitive_information_policy_config"`
+	Status                           fwtypes.StringEnum[awstypes.GuardrailStatus]                                    `tfsdk:"status"`
+	Tags                             types.Map                                                                       `tfsdk:"tags"`
+	TagsAll                          types.Map                                                                       `tfsdk:"tags_all"`
+	Timeouts                         timeouts.Value                                                                  `tfsdk:"timeouts"`
+	TopicPolicyConfig                fwtypes.ListNestedObjectValueOf[guardrailTopicPolicyConfigModel]                `tfsdk:"topic_policy_config"`
+	Version                          types.String                                                                    `tfsdk:"version"`
+	WordPolicyConfig                 fwtypes.ListNestedObjectValueOf[guardrailWordPolicyConfigModel]                 `tfsdk:"word_policy_config"`
+}
+
+func (data *guardrailResourceModel) InitFromID() error {
+	data.GuardrailID = data.ID
+
+	return nil
+}
+
+func (data *guardrailResourceModel) setID() {
+	data.ID = data.GuardrailID
+}
+
+func (data *guardrailResourceModel) flatten(ctx context.Context, output *bedrock.GetGuardrailOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, output, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	// The policies returned by GetGuardrail are not named the same as those sent to CreateGuardrail and UpdateGuardrail.
+	diags.Append(fwflex.Flatten(ctx, guardrailPolicyConfigsFromGetGuardrailOutput(output), data)...)
+
+	return diags
+}
+
+// guardrailPolicyConfigs holds the policy configurations in the shape used by CreateGuardrail and UpdateGuardrail.
+type guardrailPolicyConfigs struct {
+	ContentPolicyConfig              *awstypes.GuardrailContentPolicyConfig
+	ContextualGroundingPolicyConfig  *awstypes.GuardrailContextualGroundingPolicyConfig
+	SensitiveInformationPolicyConfig *awstypes.GuardrailSensitiveInformationPolicyConfig
+	TopicPolicyConfig                *awstypes.GuardrailTopicPolicyConfig
+	WordPolicyConfig                 *awstypes.GuardrailWordPolicyConfig
+}
+
+func guardrailPolicyConfigsFromGetGuardrailOutput(output *bedrock.GetGuardrailOutput) *guardrailPolicyConfigs {
+	apiObject := &guardrailPolicyConfigs{}
+
+	if v := output.ContentPolicy; v != nil {
+		apiObject.ContentPolicyConfig = &awstypes.GuardrailContentPolicyConfig{
+			FiltersConfig: tfslices.ApplyToAll(v.Filters, func(v awstypes.GuardrailContentFilter) awstypes.GuardrailContentFilterConfig {
+				return awstypes.GuardrailContentFilterConfig{
+					InputModalities:  v.InputModalities,
+					InputStrength:    v.InputStrength,
+					OutputModalities: v.OutputModalities,
+					OutputStrength:   v.OutputStrength,
+					Type:             v.Type,
+				}
+			}),
+		}
+	}
+
+	if v := output.ContextualGroundingPolicy; v != nil {
+		apiObject.ContextualGroundingPolicyConfig = &awstypes.GuardrailContextualGroundingPolicyConfig{
+			FiltersConfig: tfslices.ApplyToAll(v.Filters, func(v awstypes.GuardrailContextualGroundingFilter) awstypes.GuardrailContextualGroundingFilterConfig {
+				return awstypes.GuardrailContextualGroundingFilterConfig{
+					Threshold: v.Threshold,
+					Type:      v.Type,
+				}
+			}),
+		}
+	}
+
+	if v := output.SensitiveInformationPolicy; v != nil {
+		apiObject.SensitiveInformationPolicyConfig = &awstypes.GuardrailSensitiveInformationPolicyConfig{
+			PiiEntitiesConfig: tfslices.ApplyToAll(v.PiiEntities, func(v awstypes.GuardrailPiiEntity) awstypes.GuardrailPiiEntityConfig {
+				return awstypes.GuardrailPiiEntityConfig{
+					Action: v.Action,
+					Type:   v.Type,
+				}
+			}),
+			RegexesConfig: tfslices.ApplyToAll(v.Regexes, func(v awstypes.GuardrailRegex) awstypes.GuardrailRegexConfig {
+				return awstypes.GuardrailRegexConfig{
+					Action:      v.Action,
+					Description: v.Description,
+					Name:        v.Name,
+					Pattern:     v.Pattern,
+				}
+			}),
+		}
+	}
+
+	if v := output.TopicPolicy; v != nil {
+		apiObject.TopicPolicyConfig = &awstypes.GuardrailTopicPolicyConfig{
+			TopicsConfig: tfslices.ApplyToAll(v.Topics, func(v awstypes.GuardrailTopic) awstypes.GuardrailTopicConfig {
+				return awstypes.GuardrailTopicConfig{
+					Definition: v.Definition,
+					Examples:   v.Examples,
+					Name:       v.Name,
+					Type:       v.Type,
+				}
+			}),
+		}
+	}
+
+	if v := output.WordPolicy; v != nil {
+		apiObject.WordPolicyConfig = &awstypes.GuardrailWordPolicyConfig{
+			ManagedWordListsConfig: tfslices.ApplyToAll(v.ManagedWordLists, func(v awstypes.GuardrailManagedWords) awstypes.GuardrailManagedWordsConfig {
+				return awstypes.GuardrailManagedWordsConfig{
+					Type: v.Type,
+				}
+			}),
+			WordsConfig: tfslices.ApplyToAll(v.Words, func(v awstypes.GuardrailWord) awstypes.GuardrailWordConfig {
+				return awstypes.GuardrailWordConfig{
+					Text: v.Text,
+				}
+			}),
+		}
+	}
+
+	return apiObject
+}
+
+type guardrailContentPolicyConfigModel struct {
+	FiltersConfig fwtypes.SetNestedObjectValueOf[guardrailContentFilterConfigModel] `tfsdk:"filters_config"`
+}
+
+type guardrailContentFilterConfigModel struct {
+	InputStrength  fwtypes.StringEnum[awstypes.GuardrailFilterStrength]    `tfsdk:"input_strength"`
+	OutputStrength fwtypes.StringEnum[awstypes.GuardrailFilterStrength]    `tfsdk:"output_strength"`
+	Type           fwtypes.StringEnum[awstypes.GuardrailContentFilterType] `tfsdk:"type"`
+}
+
+type guardrailContextualGroundingPolicyConfigModel struct {
+	FiltersConfig fwtypes.SetNestedObjectValueOf[guardrailContextualGroundingFilterConfigModel] `tfsdk:"filters_config"`
+}
+
+type guardrailContextualGroundingFilterConfigModel struct {
+	Threshold types.Float64                                                       `tfsdk:"threshold"`
+	Type      fwtypes.StringEnum[awstypes.GuardrailContextualGroundingFilterType] `tfsdk:"type"`
+}
+
+type guardrailSensitiveInformationPolicyConfigModel struct {
+	PIIEntitiesConfig fwtypes.SetNestedObjectValueOf[guardrailPIIEntityConfigModel] `tfsdk:"pii_entities_config"`
+	RegexesConfig     fwtypes.SetNestedObjectValueOf[guardrailRegexConfigModel]     `tfsdk:"regexes_config"`
+}
+
+type guardrailPIIEntityConfigModel struct {
+	Action fwtypes.StringEnum[awstypes.GuardrailSensitiveInformationAction] `tfsdk:"action"`
+	Type   fwtypes.StringEnum[awstypes.GuardrailPiiEntityType]              `tfsdk:"type"`
+}
+
+type guardrailRegexConfigModel struct {
+	Action      fwtypes.StringEnum[awstypes.GuardrailSensitiveInformationAction] `tfsdk:"action"`
+	Description types.String                                                     `tfsdk:"description"`
+	Name        types.String                                                     `tfsdk:"name"`
+	Pattern     types.String                                                     `tfsdk:"pattern"`
+}
+
+type guardrailTopicPolicyConfigModel struct {
+	TopicsConfig fwtypes.ListNestedObjectValueOf[guardrailTopicConfigModel] `tfsdk:"topics_config"`
+}
+
+type guardrailTopicConfigModel struct {
+	Definition types.String                                    `tfsdk:"definition"`
+	Examples   fwtypes.ListValueOf[types.String]               `tfsdk:"examples"`
+	Name       types.String                                    `tfsdk:"name"`
+	Type       fwtypes.StringEnum[awstypes.GuardrailTopicType] `tfsdk:"type"`
+}
+
+type guardrailWordPolicyConfigModel struct {
+	ManagedWordListsConfig fwtypes.SetNestedObjectValueOf[guardrailManagedWordsConfigModel] `tfsdk:"managed_word_lists_config"`
+	WordsConfig            fwtypes.SetNestedObjectValueOf[guardrailWordConfigModel]         `tfsdk:"words_config"`
+}
+
+type guardrailManagedWordsConfigModel struct {
+	Type fwtypes.StringEnum[awstypes.GuardrailManagedWordsType] `tfsdk:"type"`
+}
+
+type guardrailWordConfigModel struct {
+	Text types.String `tfsdk:"text"`
+}
@@ -0,0 +1,81 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrock_test
+
+import (
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccBedrockGuardrailDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_bedrock_guardrail.test"
+	resourceName := "aws_bedrock_guardrail.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardrailDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, "blocked_input_messaging", resourceName, "blocked_input_messaging"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "blocked_outputs_messaging", resourceName, "blocked_outputs_messaging"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrCreatedAt, resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrDescription, resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttrPair(dataSourceName, "guardrail_arn", resourceName, "guardrail_arn"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "guardrail_id", resourceName, "guardrail_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrName, resourceName, names.AttrName),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrStatus, "READY"),
+					resource.TestCheckResourceAttr(dataSourceName, names.AttrVersion, "DRAFT"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccBedrockGuardrailDataSource_version(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_bedrock_guardrail.test"
+	resourceName := "aws_bedrock_guardrail_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); acctest.PreCheckPartitionHasService(t, names.BedrockEndpointID) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.BedrockServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccGuardrailDataSourceConfig_version(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrDescription, resourceName, names.AttrDescription),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrVersion, resourceName, names.AttrVersion),
+				),
+			},
+		},
+	})
+}
+
+func testAccGuardrailDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccGuardrailConfig_basic(rName), `
+data "aws_bedrock_guardrail" "test" {
+  guardrail_id = aws_bedrock_guardrail.test.guardrail_id
+}
+`)
+}
+
+func testAccGuardrailDataSourceConfig_version(rName string) string {
+	return acctest.ConfigCompose(testAccGuardrailVersionConfig_basic(rName, "test", false), `
+data "aws_bedrock_guardrail" "test" {
+  guardrail_id = aws_bedrock_guardrail.test.guardrail_id
+  version      = aws_bedrock_guardrail_version.test.version
+}
+`)
+}
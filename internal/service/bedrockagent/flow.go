@@ -0,0 +1,1498 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package bedrockagent
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/bedrockagent"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/bedrockagent/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Flow")
+// @Tags(identifierAttribute="arn")
+func newFlowResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &flowResource{}
+
+	r.SetDefaultCreateTimeout(5 * time.Minute)
+	r.SetDefaultUpdateTimeout(5 * time.Minute)
+
+	return r, nil
+}
+
+type flowResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+}
+
+func (*flowResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_bedrockagent_flow"
+}
+
+func (r *flowResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	guardrailConfigurationBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[guardrailConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"guardrail_identifier": schema.StringAttribute{
+					Required: true,
+				},
+				"guardrail_version": schema.StringAttribute{
+					Required: true,
+				},
+			},
+		},
+	}
+	s3ServiceConfigurationBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeS3ServiceConfigurationModel](ctx),
+		Validators: []validator.List{
+			listvalidator.SizeAtMost(1),
+		},
+		NestedObject: schema.NestedBlockObject{
+			Blocks: map[string]schema.Block{
+				"s3": schema.ListNestedBlock{
+					CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeS3ConfigurationModel](ctx),
+					Validators: []validator.List{
+						listvalidator.IsRequired(),
+						listvalidator.SizeAtMost(1),
+					},
+					NestedObject: schema.NestedBlockObject{
+						Attributes: map[string]schema.Attribute{
+							names.AttrBucketName: schema.StringAttribute{
+								Required: true,
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+	// Exactly one configuration block may be specified per node.
+	nodeConfigurationPaths := []path.Expression{
+		path.MatchRelative().AtParent().AtName("agent"),
+		path.MatchRelative().AtParent().AtName(names.AttrCondition),
+		path.MatchRelative().AtParent().AtName("knowledge_base"),
+		path.MatchRelative().AtParent().AtName("lambda_function"),
+		path.MatchRelative().AtParent().AtName("lex"),
+		path.MatchRelative().AtParent().AtName("prompt"),
+		path.MatchRelative().AtParent().AtName("retrieval"),
+		path.MatchRelative().AtParent().AtName("storage"),
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"customer_encryption_key_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 200),
+				},
+			},
+			names.AttrExecutionRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				Validators: []validator.String{
+					stringvalidator.RegexMatches(regexache.MustCompile(`^([0-9a-zA-Z][_-]?){1,100}$`), "valid characters are a-z, A-Z, 0-9, _ (underscore) and - (hyphen). The name can have up to 100 characters"),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.FlowStatus](),
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+			"updated_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			names.AttrVersion: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"definition": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[flowDefinitionModel](ctx),
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"connection": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[flowConnectionModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrSource: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrTarget: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.FlowConnectionType](),
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrConfiguration: schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[flowConnectionConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"conditional": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[flowConditionalConnectionConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+														listvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("data")),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															names.AttrCondition: schema.StringAttribute{
+																Required: true,
+															},
+														},
+													},
+												},
+												"data": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[flowDataConnectionConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"source_output": schema.StringAttribute{
+																Required: true,
+															},
+															"target_input": schema.StringAttribute{
+																Required: true,
+															},
+														},
+													},
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+						"node": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeModel](ctx),
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrName: schema.StringAttribute{
+										Required: true,
+									},
+									names.AttrType: schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.FlowNodeType](),
+										Required:   true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									names.AttrConfiguration: schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeConfigurationModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Blocks: map[string]schema.Block{
+												"agent": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[agentFlowNodeConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+														listvalidator.ExactlyOneOf(nodeConfigurationPaths...),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"agent_alias_arn": schema.StringAttribute{
+																CustomType: fwtypes.ARNType,
+																Required:   true,
+															},
+														},
+													},
+												},
+												names.AttrCondition: schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[conditionFlowNodeConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Blocks: map[string]schema.Block{
+															names.AttrCondition: schema.ListNestedBlock{
+																CustomType: fwtypes.NewListNestedObjectTypeOf[flowConditionModel](ctx),
+																Validators: []validator.List{
+																	listvalidator.IsRequired(),
+																	listvalidator.SizeBetween(1, 5),
+																},
+																NestedObject: schema.NestedBlockObject{
+																	Attributes: map[string]schema.Attribute{
+																		names.AttrExpression: schema.StringAttribute{
+																			Optional: true,
+																		},
+																		names.AttrName: schema.StringAttribute{
+																			Required: true,
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+												"knowledge_base": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[knowledgeBaseFlowNodeConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"knowledge_base_id": schema.StringAttribute{
+																Required: true,
+															},
+															"model_id": schema.StringAttribute{
+																Optional: true,
+															},
+														},
+														Blocks: map[string]schema.Block{
+															"guardrail_configuration": guardrailConfigurationBlock,
+														},
+													},
+												},
+												"lambda_function": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[lambdaFunctionFlowNodeConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"lambda_arn": schema.StringAttribute{
+																CustomType: fwtypes.ARNType,
+																Required:   true,
+															},
+														},
+													},
+												},
+												"lex": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[lexFlowNodeConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Attributes: map[string]schema.Attribute{
+															"bot_alias_arn": schema.StringAttribute{
+																CustomType: fwtypes.ARNType,
+																Required:   true,
+															},
+															"locale_id": schema.StringAttribute{
+																Required: true,
+															},
+														},
+													},
+												},
+												"prompt": schema.ListNestedBlock{
+													CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeConfigurationModel](ctx),
+													Validators: []validator.List{
+														listvalidator.SizeAtMost(1),
+													},
+													NestedObject: schema.NestedBlockObject{
+														Blocks: map[string]schema.Block{
+															"guardrail_configuration": guardrailConfigurationBlock,
+															"source_configuration": schema.ListNestedBlock{
+																CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeSourceConfigurationModel](ctx),
+																Validators: []validator.List{
+																	listvalidator.IsRequired(),
+																	listvalidator.SizeAtMost(1),
+																},
+																NestedObject: schema.NestedBlockObject{
+																	Blocks: map[string]schema.Block{
+																		"inline": schema.ListNestedBlock{
+																			CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeInlineConfigurationModel](ctx),
+																			Validators: []validator.List{
+																				listvalidator.SizeAtMost(1),
+																				listvalidator.ExactlyOneOf(path.MatchRelative().AtParent().AtName("resource")),
+																			},
+																			NestedObject: schema.NestedBlockObject{
+																				Attributes: map[string]schema.Attribute{
+																					"model_id": schema.StringAttribute{
+																						Required: true,
+																					},
+																					"template_type": schema.StringAttribute{
+																						CustomType: fwtypes.StringEnumType[awstypes.PromptTemplateType](),
+																						Required:   true,
+																					},
+																				},
+																				Blocks: map[string]schema.Block{
+																					"inference_configuration": promptInferenceConfigurationBlock(ctx),
+																					"template_configuration":  promptTemplateConfigurationBlock(ctx),
+																				},
+																			},
+																		},
+																		"resource": schema.ListNestedBlock{
+																			CustomType: fwtypes.NewListNestedObjectTypeOf[promptFlowNodeResourceConfigurationModel](ctx),
+																			Validators: []validator.List{
+																				listvalidator.SizeAtMost(1),
+																			},
+																			NestedObject: schema.NestedBlockObject{
+																				Attributes: map[string]schema.Attribute{
+																					"prompt_arn": schema.StringAttribute{
+																						CustomType: fwtypes.ARNType,
+																						Required:   true,
+																					},
+																				},
+																			},
+																		},
+																	},
+																},
+															},
+														},
+													},
+												},
+												"retrieval": s3ServiceConfigurationBlock,
+												"storage":   s3ServiceConfigurationBlock,
+											},
+										},
+									},
+									"input": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeInputModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrExpression: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrName: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrType: schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.FlowNodeIODataType](),
+													Required:   true,
+												},
+											},
+										},
+									},
+									"output": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[flowNodeOutputModel](ctx),
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												names.AttrName: schema.StringAttribute{
+													Required: true,
+												},
+												names.AttrType: schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.FlowNodeIODataType](),
+													Required:   true,
+												},
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+			}),
+		},
+	}
+}
+
+func (r *flowResource) ValidateConfig(ctx context.Context, request resource.ValidateConfigRequest, response *resource.ValidateConfigResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	definitionData, diags := data.Definition.ToPtr(ctx)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() || definitionData == nil {
+		return
+	}
+
+	response.Diagnostics.Append(validateFlowDefinition(ctx, definitionData, path.Root("definition").AtListIndex(0))...)
+}
+
+func (r *flowResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	input := &bedrockagent.CreateFlowInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// AutoFlEx doesn't yet handle union types.
+	definition, diags := expandFlowDefinition(ctx, data.Definition)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	input.ClientToken = aws.String(id.UniqueId())
+	input.Definition = definition
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateFlow(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Bedrock Agent Flow (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.Id)
+
+	flow, err := prepareFlow(ctx, conn, data.ID.ValueString(), definition != nil, r.CreateTimeout(ctx, data.Timeouts))
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("preparing Bedrock Agent Flow (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.ARN = fwflex.StringToFramework(ctx, flow.Arn)
+	data.CreatedAt = fwflex.TimeToFramework(ctx, flow.CreatedAt)
+	data.Status = fwtypes.StringEnumValue(flow.Status)
+	data.UpdatedAt = fwflex.TimeToFramework(ctx, flow.UpdatedAt)
+	data.Version = fwflex.StringToFramework(ctx, flow.Version)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	output, err := findFlowByID(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Bedrock Agent Flow (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// AutoFlEx doesn't yet handle union types.
+	definition, diags := flattenFlowDefinition(ctx, output.Definition)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Definition = definition
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *flowResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	if !new.CustomerEncryptionKeyARN.Equal(old.CustomerEncryptionKeyARN) ||
+		!new.Definition.Equal(old.Definition) ||
+		!new.Description.Equal(old.Description) ||
+		!new.ExecutionRoleARN.Equal(old.ExecutionRoleARN) ||
+		!new.Name.Equal(old.Name) {
+		input := &bedrockagent.UpdateFlowInput{}
+		response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		// AutoFlEx doesn't yet handle union types.
+		definition, diags := expandFlowDefinition(ctx, new.Definition)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		input.Definition = definition
+		input.FlowIdentifier = fwflex.StringFromFramework(ctx, new.ID)
+
+		_, err := conn.UpdateFlow(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Bedrock Agent Flow (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		flow, err := prepareFlow(ctx, conn, new.ID.ValueString(), definition != nil, r.UpdateTimeout(ctx, new.Timeouts))
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("preparing Bedrock Agent Flow (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		new.Status = fwtypes.StringEnumValue(flow.Status)
+		new.UpdatedAt = fwflex.TimeToFramework(ctx, flow.UpdatedAt)
+	} else {
+		new.Status = old.Status
+		new.UpdatedAt = old.UpdatedAt
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *flowResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data flowResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().BedrockAgentClient(ctx)
+
+	_, err := conn.DeleteFlow(ctx, &bedrockagent.DeleteFlowInput{
+		FlowIdentifier: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Bedrock Agent Flow (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *flowResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+// prepareFlow prepares the working draft of a flow so that it can be versioned and invoked.
+// A flow without a definition can't be prepared, in which case its current state is returned.
+func prepareFlow(ctx context.Context, conn *bedrockagent.Client, id string, hasDefinition bool, timeout time.Duration) (*bedrockagent.GetFlowOutput, error) {
+	if !hasDefinition {
+		return findFlowByID(ctx, conn, id)
+	}
+
+	_, err := conn.PrepareFlow(ctx, &bedrockagent.PrepareFlowInput{
+		FlowIdentifier: aws.String(id),
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return waitFlowPrepared(ctx, conn, id, timeout)
+}
+
+func findFlowByID(ctx context.Context, conn *bedrockagent.Client, id string) (*bedrockagent.GetFlowOutput, error) {
+	input := &bedrockagent.GetFlowInput{
+		FlowIdentifier: aws.String(id),
+	}
+
+	output, err := conn.GetFlow(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func statusFlow(ctx context.Context, conn *bedrockagent.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findFlowByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, string(output.Status), nil
+	}
+}
+
+func waitFlowPrepared(ctx context.Context, conn *bedrockagent.Client, id string, timeout time.Duration) (*bedrockagent.GetFlowOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.FlowStatusNotPrepared, awstypes.FlowStatusPreparing),
+		Target:  enum.Slice(awstypes.FlowStatusPrepared),
+		Refresh: statusFlow(ctx, conn, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*bedrockagent.GetFlowOutput); ok {
+		tfresource.SetLastError(err, errors.Join(tfslices.ApplyToAll(output.Validations, func(v awstypes.FlowValidation) error {
+			return errors.New(aws.ToString(v.Message))
+		})...))
+
+		return output, err
+	}
+
+	return nil, err
+}
+
+// validateFlowDefinition checks that each connection in a flow definition references nodes that exist
+// and that any data or conditional connection configuration references an existing node output, input or condition.
+// Values that are unknown at plan time are not checked.
+func validateFlowDefinition(ctx context.Context, data *flowDefinitionModel, p path.Path) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	nodesData, d := data.Nodes.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	nodes := make(map[string]*flowNodeModel, len(nodesData))
+	for _, nodeData := range nodesData {
+		if nodeData.Name.IsUnknown() {
+			return diags
+		}
+
+		nodes[nodeData.Name.ValueString()] = nodeData
+	}
+
+	connectionsData, d := data.Connections.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for i, connectionData := range connectionsData {
+		connectionPath := p.AtName("connection").AtListIndex(i)
+		name := connectionData.Name.ValueString()
+
+		var source, target *flowNodeModel
+		for _, v := range []struct {
+			attr  string
+			value types.String
+			node  **flowNodeModel
+		}{
+			{names.AttrSource, connectionData.Source, &source},
+			{names.AttrTarget, connectionData.Target, &target},
+		} {
+			if v.value.IsUnknown() || v.value.IsNull() {
+				continue
+			}
+
+			node, ok := nodes[v.value.ValueString()]
+			if !ok {
+				diags.AddAttributeError(
+					connectionPath.AtName(v.attr),
+					"Invalid Attribute Configuration",
+					fmt.Sprintf("Connection %q %s node %q is not defined in the flow definition.", name, v.attr, v.value.ValueString()),
+				)
+
+				continue
+			}
+
+			*v.node = node
+		}
+
+		configurationData, d := connectionData.Configuration.ToPtr(ctx)
+		diags.Append(d...)
+		if d.HasError() || configurationData == nil {
+			continue
+		}
+
+		configurationPath := connectionPath.AtName(names.AttrConfiguration).AtListIndex(0)
+
+		if dataData, d := configurationData.Data.ToPtr(ctx); d.HasError() {
+			diags.Append(d...)
+		} else if dataData != nil {
+			dataPath := configurationPath.AtName("data").AtListIndex(0)
+
+			if source != nil {
+				diags.Append(validateFlowNodeReference(ctx, source.Outputs, func(v *flowNodeOutputModel) types.String { return v.Name }, dataData.SourceOutput, dataPath.AtName("source_output"), name, "output")...)
+			}
+
+			if target != nil {
+				diags.Append(validateFlowNodeReference(ctx, target.Inputs, func(v *flowNodeInputModel) types.String { return v.Name }, dataData.TargetInput, dataPath.AtName("target_input"), name, "input")...)
+			}
+		}
+
+		if conditionalData, d := configurationData.Conditional.ToPtr(ctx); d.HasError() {
+			diags.Append(d...)
+		} else if conditionalData != nil && source != nil {
+			conditions := fwtypes.NewListNestedObjectValueOfNull[flowConditionModel](ctx)
+			if nodeConfigurationData, d := source.Configuration.ToPtr(ctx); d.HasError() {
+				diags.Append(d...)
+				continue
+			} else if nodeConfigurationData != nil {
+				if conditionData, d := nodeConfigurationData.Condition.ToPtr(ctx); d.HasError() {
+					diags.Append(d...)
+					continue
+				} else if conditionData != nil {
+					conditions = conditionData.Conditions
+				}
+			}
+
+			diags.Append(validateFlowNodeReference(ctx, conditions, func(v *flowConditionModel) types.String { return v.Name }, conditionalData.Condition, configurationPath.AtName("conditional").AtListIndex(0).AtName(names.AttrCondition), name, names.AttrCondition)...)
+		}
+	}
+
+	return diags
+}
+
+// validateFlowNodeReference checks that the value referenced by a connection is the name of one of a node's inputs, outputs or conditions.
+func validateFlowNodeReference[T any](ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[T], nameOf func(*T) types.String, reference types.String, p path.Path, connection, kind string) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	if tfList.IsUnknown() || reference.IsUnknown() || reference.IsNull() {
+		return diags
+	}
+
+	data, d := tfList.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	for _, v := range data {
+		name := nameOf(v)
+
+		if name.IsUnknown() || name.ValueString() == reference.ValueString() {
+			return diags
+		}
+	}
+
+	diags.AddAttributeError(
+		p,
+		"Invalid Attribute Configuration",
+		fmt.Sprintf("Connection %q references %s %q, which is not defined on the node.", connection, kind, reference.ValueString()),
+	)
+
+	return diags
+}
+
+type flowResourceModel struct {
+	ARN                      types.String                                         `tfsdk:"arn"`
+	CreatedAt                timetypes.RFC3339                                    `tfsdk:"created_at"`
+	CustomerEncryptionKeyARN fwtypes.ARN                                          `tfsdk:"customer_encryption_key_arn"`
+	Definition               fwtypes.ListNestedObjectValueOf[flowDefinitionModel] `tfsdk:"definition"`
+	Description              types.String                                         `tfsdk:"description"`
+	ExecutionRoleARN         fwtypes.ARN                                          `tfsdk:"execution_role_arn"`
+	ID                       types.String                                         `tfsdk:"id"`
+	Name                     types.String                                         `tfsdk:"name"`
+	Status                   fwtypes.StringEnum[awstypes.FlowStatus]              `tfsdk:"status"`
+	Tags                     types.Map                                            `tfsdk:"tags"`
+	TagsAll                  types.Map                                            `tfsdk:"tags_all"`
+	Timeouts                 timeouts.Value                                       `tfsdk:"timeouts"`
+	UpdatedAt                timetypes.RFC3339                                    `tfsdk:"updated_at"`
+	Version                  types.String                                         `tfsdk:"version"`
+}
+
+type flowDefinitionModel struct {
+	Connections fwtypes.ListNestedObjectValueOf[flowConnectionModel] `tfsdk:"connection"`
+	Nodes       fwtypes.ListNestedObjectValueOf[flowNodeModel]       `tfsdk:"node"`
+}
+
+type flowConnectionModel struct {
+	Configuration fwtypes.ListNestedObjectValueOf[flowConnectionConfigurationModel] `tfsdk:"configuration"`
+	Name          types.String                                                      `tfsdk:"name"`
+	Source        types.String                                                      `tfsdk:"source"`
+	Target        types.String                                                      `tfsdk:"target"`
+	Type          fwtypes.StringEnum[awstypes.FlowConnectionType]                   `tfsdk:"type"`
+}
+
+type flowConnectionConfigurationModel struct {
+	Conditional fwtypes.ListNestedObjectValueOf[flowConditionalConnectionConfigurationModel] `tfsdk:"conditional"`
+	Data        fwtypes.ListNestedObjectValueOf[flowDataConnectionConfigurationModel]        `tfsdk:"data"`
+}
+
+type flowConditionalConnectionConfigurationModel struct {
+	Condition types.String `tfsdk:"condition"`
+}
+
+type flowDataConnectionConfigurationModel struct {
+	SourceOutput types.String `tfsdk:"source_output"`
+	TargetInput  types.String `tfsdk:"target_input"`
+}
+
+type flowNodeModel struct {
+	Configuration fwtypes.ListNestedObjectValueOf[flowNodeConfigurationModel] `tfsdk:"configuration"`
+	Inputs        fwtypes.ListNestedObjectValueOf[flowNodeInputModel]         `tfsdk:"input"`
+	Name          types.String                                                `tfsdk:"name"`
+	Outputs       fwtypes.ListNestedObjectValueOf[flowNodeOutputModel]        `tfsdk:"output"`
+	Type          fwtypes.StringEnum[awstypes.FlowNodeType]                   `tfsdk:"type"`
+}
+
+type flowNodeInputModel struct {
+	Expression types.String                                    `tfsdk:"expression"`
+	Name       types.String                                    `tfsdk:"name"`
+	Type       fwtypes.StringEnum[awstypes.FlowNodeIODataType] `tfsdk:"type"`
+}
+
+type flowNodeOutputModel struct {
+	Name types.String                                    `tfsdk:"name"`
+	Type fwtypes.StringEnum[awstypes.FlowNodeIODataType] `tfsdk:"type"`
+}
+
+type flowNodeConfigurationModel struct {
+	Agent          fwtypes.ListNestedObjectValueOf[agentFlowNodeConfigurationModel]          `tfsdk:"agent"`
+	Condition      fwtypes.ListNestedObjectValueOf[conditionFlowNodeConfigurationModel]      `tfsdk:"condition"`
+	KnowledgeBase  fwtypes.ListNestedObjectValueOf[knowledgeBaseFlowNodeConfigurationModel]  `tfsdk:"knowledge_base"`
+	LambdaFunction fwtypes.ListNestedObjectValueOf[lambdaFunctionFlowNodeConfigurationModel] `tfsdk:"lambda_function"`
+	Lex            fwtypes.ListNestedObjectValueOf[lexFlowNodeConfigurationModel]            `tfsdk:"lex"`
+	Prompt         fwtypes.ListNestedObjectValueOf[promptFlowNodeConfigurationModel]         `tfsdk:"prompt"`
+	Retrieval      fwtypes.ListNestedObjectValueOf[flowNodeS3ServiceConfigurationModel]      `tfsdk:"retrieval"`
+	Storage        fwtypes.ListNestedObjectValueOf[flowNodeS3ServiceConfigurationModel]      `tfsdk:"storage"`
+}
+
+type agentFlowNodeConfigurationModel struct {
+	AgentAliasARN fwtypes.ARN `tfsdk:"agent_alias_arn"`
+}
+
+type conditionFlowNodeConfigurationModel struct {
+	Conditions fwtypes.ListNestedObjectValueOf[flowConditionModel] `tfsdk:"condition"`
+}
+
+type flowConditionModel struct {
+	Expression types.String `tfsdk:"expression"`
+	Name       types.String `tfsdk:"name"`
+}
+
+type knowledgeBaseFlowNodeConfigurationModel struct {
+	GuardrailConfiguration fwtypes.ListNestedObjectValueOf[guardrailConfigurationModel] `tfsdk:"guardrail_configuration"`
+	KnowledgeBaseID        types.String                                                 `tfsdk:"knowledge_base_id"`
+	ModelID                types.String                                                 `tfsdk:"model_id"`
+}
+
+type guardrailConfigurationModel struct {
+	GuardrailIdentifier types.String `tfsdk:"guardrail_identifier"`
+	GuardrailVersion    types.String `tfsdk:"guardrail_version"`
+}
+
+type lambdaFunctionFlowNodeConfigurationModel struct {
+	LambdaARN fwtypes.ARN `tfsdk:"lambda_arn"`
+}
+
+type lexFlowNodeConfigurationModel struct {
+	BotAliasARN fwtypes.ARN  `tfsdk:"bot_alias_arn"`
+	LocaleID    types.String `tfsdk:"locale_id"`
+}
+
+type promptFlowNodeConfigurationModel struct {
+	GuardrailConfiguration fwtypes.ListNestedObjectValueOf[guardrailConfigurationModel]            `tfsdk:"guardrail_configuration"`
+	SourceConfiguration    fwtypes.ListNestedObjectValueOf[promptFlowNodeSourceConfigurationModel] `tfsdk:"source_configuration"`
+}
+
+type promptFlowNodeSourceConfigurationModel struct {
+	Inline   fwtypes.ListNestedObjectValueOf[promptFlowNodeInlineConfigurationModel]   `tfsdk:"inline"`
+	Resource fwtypes.ListNestedObjectValueOf[promptFlowNodeResourceConfigurationModel] `tfsdk:"resource"`
+}
+
+type promptFlowNodeInlineConfigurationModel struct {
+	InferenceConfiguration fwtypes.ListNestedObjectValueOf[promptInferenceConfigurationModel] `tfsdk:"inference_configuration"`
+	ModelID                types.String                                                       `tfsdk:"model_id"`
+	TemplateConfiguration  fwtypes.ListNestedObjectValueOf[promptTemplateConfigurationModel]  `tfsdk:"template_configuration"`
+	TemplateType           fwtypes.StringEnum[awstypes.PromptTemplateType]                    `tfsdk:"template_type"`
+}
+
+type promptFlowNodeResourceConfigurationModel struct {
+	PromptARN fwtypes.ARN `tfsdk:"prompt_arn"`
+}
+
+type flowNodeS3ServiceConfigurationModel struct {
+	S3 fwtypes.ListNestedObjectValueOf[flowNodeS3ConfigurationModel] `tfsdk:"s3"`
+}
+
+type flowNodeS3ConfigurationModel struct {
+	BucketName types.String `tfsdk:"bucket_name"`
+}
+
+func expandFlowDefinition(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[flowDefinitionModel]) (*awstypes.FlowDefinition, diag.Diagnostics) {
+	data, diags := tfList.ToPtr(ctx)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	var apiObject awstypes.FlowDefinition
+	diags.Append(fwflex.Expand(ctx, data, &apiObject)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	nodesData, d := data.Nodes.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for i, nodeData := range nodesData {
+		apiObject.Nodes[i].Configuration, d = expandFlowNodeConfiguration(ctx, nodeData)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	connectionsData, d := data.Connections.ToSlice(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	for i, connectionData := range connectionsData {
+		apiObject.Connections[i].Configuration, d = expandFlowConnectionConfiguration(ctx, connectionData.Configuration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+	}
+
+	return &apiObject, diags
+}
+
+func flattenFlowDefinition(ctx context.Context, apiObject *awstypes.FlowDefinition) (fwtypes.ListNestedObjectValueOf[flowDefinitionModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil || (len(apiObject.Connections) == 0 && len(apiObject.Nodes) == 0) {
+		return fwtypes.NewListNestedObjectValueOfNull[flowDefinitionModel](ctx), diags
+	}
+
+	nodesData := make([]*flowNodeModel, 0, len(apiObject.Nodes))
+	for _, apiObject := range apiObject.Nodes {
+		var nodeData flowNodeModel
+		diags.Append(fwflex.Flatten(ctx, apiObject, &nodeData)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[flowDefinitionModel](ctx), diags
+		}
+
+		var d diag.Diagnostics
+		nodeData.Configuration, d = flattenFlowNodeConfiguration(ctx, apiObject.Configuration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[flowDefinitionModel](ctx), diags
+		}
+
+		nodesData = append(nodesData, &nodeData)
+	}
+
+	connectionsData := make([]*flowConnectionModel, 0, len(apiObject.Connections))
+	for _, apiObject := range apiObject.Connections {
+		var connectionData flowConnectionModel
+		diags.Append(fwflex.Flatten(ctx, apiObject, &connectionData)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[flowDefinitionModel](ctx), diags
+		}
+
+		var d diag.Diagnostics
+		connectionData.Configuration, d = flattenFlowConnectionConfiguration(ctx, apiObject.Configuration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[flowDefinitionModel](ctx), diags
+		}
+
+		connectionsData = append(connectionsData, &connectionData)
+	}
+
+	data := flowDefinitionModel{
+		Connections: fwtypes.NewListNestedObjectValueOfNull[flowConnectionModel](ctx),
+		Nodes:       fwtypes.NewListNestedObjectValueOfNull[flowNodeModel](ctx),
+	}
+	if len(connectionsData) > 0 {
+		data.Connections = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, connectionsData)
+	}
+	if len(nodesData) > 0 {
+		data.Nodes = fwtypes.NewListNestedObjectValueOfSliceMust(ctx, nodesData)
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data), diags
+}
+
+func expandFlowNodeConfiguration(ctx context.Context, nodeData *flowNodeModel) (awstypes.FlowNodeConfiguration, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	// Input, output, iterator and collector nodes have no configurable properties.
+	switch nodeData.Type.ValueEnum() {
+	case awstypes.FlowNodeTypeCollector:
+		return &awstypes.FlowNodeConfigurationMemberCollector{}, diags
+	case awstypes.FlowNodeTypeInput:
+		return &awstypes.FlowNodeConfigurationMemberInput{}, diags
+	case awstypes.FlowNodeTypeIterator:
+		return &awstypes.FlowNodeConfigurationMemberIterator{}, diags
+	case awstypes.FlowNodeTypeOutput:
+		return &awstypes.FlowNodeConfigurationMemberOutput{}, diags
+	}
+
+	data, d := nodeData.Configuration.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	switch {
+	case !data.Agent.IsNull():
+		var apiObject awstypes.AgentFlowNodeConfiguration
+		agentData, d := data.Agent.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		diags.Append(fwflex.Expand(ctx, agentData, &apiObject)...)
+
+		return &awstypes.FlowNodeConfigurationMemberAgent{Value: apiObject}, diags
+
+	case !data.Condition.IsNull():
+		var apiObject awstypes.ConditionFlowNodeConfiguration
+		conditionData, d := data.Condition.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		diags.Append(fwflex.Expand(ctx, conditionData, &apiObject)...)
+
+		return &awstypes.FlowNodeConfigurationMemberCondition{Value: apiObject}, diags
+
+	case !data.KnowledgeBase.IsNull():
+		var apiObject awstypes.KnowledgeBaseFlowNodeConfiguration
+		knowledgeBaseData, d := data.KnowledgeBase.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		diags.Append(fwflex.Expand(ctx, knowledgeBaseData, &apiObject)...)
+
+		return &awstypes.FlowNodeConfigurationMemberKnowledgeBase{Value: apiObject}, diags
+
+	case !data.LambdaFunction.IsNull():
+		var apiObject awstypes.LambdaFunctionFlowNodeConfiguration
+		lambdaFunctionData, d := data.LambdaFunction.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		diags.Append(fwflex.Expand(ctx, lambdaFunctionData, &apiObject)...)
+
+		return &awstypes.FlowNodeConfigurationMemberLambdaFunction{Value: apiObject}, diags
+
+	case !data.Lex.IsNull():
+		var apiObject awstypes.LexFlowNodeConfiguration
+		lexData, d := data.Lex.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		diags.Append(fwflex.Expand(ctx, lexData, &apiObject)...)
+
+		return &awstypes.FlowNodeConfigurationMemberLex{Value: apiObject}, diags
+
+	case !data.Prompt.IsNull():
+		promptData, d := data.Prompt.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.PromptFlowNodeConfiguration
+		diags.Append(fwflex.Expand(ctx, promptData, &apiObject)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject.SourceConfiguration, d = expandPromptFlowNodeSourceConfiguration(ctx, promptData.SourceConfiguration)
+		diags.Append(d...)
+
+		return &awstypes.FlowNodeConfigurationMemberPrompt{Value: apiObject}, diags
+
+	case !data.Retrieval.IsNull():
+		s3Data, d := flowNodeS3ConfigurationData(ctx, data.Retrieval)
+		diags.Append(d...)
+		if diags.HasError() || s3Data == nil {
+			return nil, diags
+		}
+
+		return &awstypes.FlowNodeConfigurationMemberRetrieval{
+			Value: awstypes.RetrievalFlowNodeConfiguration{
+				ServiceConfiguration: &awstypes.RetrievalFlowNodeServiceConfigurationMemberS3{
+					Value: awstypes.RetrievalFlowNodeS3Configuration{
+						BucketName: fwflex.StringFromFramework(ctx, s3Data.BucketName),
+					},
+				},
+			},
+		}, diags
+
+	case !data.Storage.IsNull():
+		s3Data, d := flowNodeS3ConfigurationData(ctx, data.Storage)
+		diags.Append(d...)
+		if diags.HasError() || s3Data == nil {
+			return nil, diags
+		}
+
+		return &awstypes.FlowNodeConfigurationMemberStorage{
+			Value: awstypes.StorageFlowNodeConfiguration{
+				ServiceConfiguration: &awstypes.StorageFlowNodeServiceConfigurationMemberS3{
+					Value: awstypes.StorageFlowNodeS3Configuration{
+						BucketName: fwflex.StringFromFramework(ctx, s3Data.BucketName),
+					},
+				},
+			},
+		}, diags
+	}
+
+	return nil, diags
+}
+
+func flowNodeS3ConfigurationData(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[flowNodeS3ServiceConfigurationModel]) (*flowNodeS3ConfigurationModel, diag.Diagnostics) {
+	data, diags := tfList.ToPtr(ctx)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	return data.S3.ToPtr(ctx)
+}
+
+func flattenFlowNodeConfiguration(ctx context.Context, apiObject awstypes.FlowNodeConfiguration) (fwtypes.ListNestedObjectValueOf[flowNodeConfigurationModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data := flowNodeConfigurationModel{
+		Agent:          fwtypes.NewListNestedObjectValueOfNull[agentFlowNodeConfigurationModel](ctx),
+		Condition:      fwtypes.NewListNestedObjectValueOfNull[conditionFlowNodeConfigurationModel](ctx),
+		KnowledgeBase:  fwtypes.NewListNestedObjectValueOfNull[knowledgeBaseFlowNodeConfigurationModel](ctx),
+		LambdaFunction: fwtypes.NewListNestedObjectValueOfNull[lambdaFunctionFlowNodeConfigurationModel](ctx),
+		Lex:            fwtypes.NewListNestedObjectValueOfNull[lexFlowNodeConfigurationModel](ctx),
+		Prompt:         fwtypes.NewListNestedObjectValueOfNull[promptFlowNodeConfigurationModel](ctx),
+		Retrieval:      fwtypes.NewListNestedObjectValueOfNull[flowNodeS3ServiceConfigurationModel](ctx),
+		Storage:        fwtypes.NewListNestedObjectValueOfNull[flowNodeS3ServiceConfigurationModel](ctx),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.FlowNodeConfigurationMemberAgent:
+		var agentData agentFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &agentData)...)
+		data.Agent = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &agentData)
+
+	case *awstypes.FlowNodeConfigurationMemberCondition:
+		var conditionData conditionFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &conditionData)...)
+		data.Condition = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &conditionData)
+
+	case *awstypes.FlowNodeConfigurationMemberKnowledgeBase:
+		var knowledgeBaseData knowledgeBaseFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &knowledgeBaseData)...)
+		data.KnowledgeBase = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &knowledgeBaseData)
+
+	case *awstypes.FlowNodeConfigurationMemberLambdaFunction:
+		var lambdaFunctionData lambdaFunctionFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &lambdaFunctionData)...)
+		data.LambdaFunction = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &lambdaFunctionData)
+
+	case *awstypes.FlowNodeConfigurationMemberLex:
+		var lexData lexFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &lexData)...)
+		data.Lex = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &lexData)
+
+	case *awstypes.FlowNodeConfigurationMemberPrompt:
+		var promptData promptFlowNodeConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &promptData)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[flowNodeConfigurationModel](ctx), diags
+		}
+
+		var d diag.Diagnostics
+		promptData.SourceConfiguration, d = flattenPromptFlowNodeSourceConfiguration(ctx, v.Value.SourceConfiguration)
+		diags.Append(d...)
+
+		data.Prompt = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &promptData)
+
+	case *awstypes.FlowNodeConfigurationMemberRetrieval:
+		if v, ok := v.Value.ServiceConfiguration.(*awstypes.RetrievalFlowNodeServiceConfigurationMemberS3); ok {
+			data.Retrieval = flattenFlowNodeS3ServiceConfiguration(ctx, v.Value.BucketName)
+		}
+
+	case *awstypes.FlowNodeConfigurationMemberStorage:
+		if v, ok := v.Value.ServiceConfiguration.(*awstypes.StorageFlowNodeServiceConfigurationMemberS3); ok {
+			data.Storage = flattenFlowNodeS3ServiceConfiguration(ctx, v.Value.BucketName)
+		}
+
+	default:
+		// Input, output, iterator and collector nodes have no configurable properties.
+		return fwtypes.NewListNestedObjectValueOfNull[flowNodeConfigurationModel](ctx), diags
+	}
+
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[flowNodeConfigurationModel](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data), diags
+}
+
+func flattenFlowNodeS3ServiceConfiguration(ctx context.Context, bucketName *string) fwtypes.ListNestedObjectValueOf[flowNodeS3ServiceConfigurationModel] {
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowNodeS3ServiceConfigurationModel{
+		S3: fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &flowNodeS3ConfigurationModel{
+			BucketName: fwflex.StringToFramework(ctx, bucketName),
+		}),
+	})
+}
+
+func expandPromptFlowNodeSourceConfiguration(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[promptFlowNodeSourceConfigurationModel]) (awstypes.PromptFlowNodeSourceConfiguration, diag.Diagnostics) {
+	data, diags := tfList.ToPtr(ctx)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	if !data.Inline.IsNull() {
+		inlineData, d := data.Inline.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		var apiObject awstypes.PromptFlowNodeInlineConfiguration
+		diags.Append(fwflex.Expand(ctx, inlineData, &apiObject)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject.InferenceConfiguration, d = expandPromptInferenceConfiguration(ctx, inlineData.InferenceConfiguration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		apiObject.TemplateConfiguration, d = expandPromptTemplateConfiguration(ctx, inlineData.TemplateConfiguration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &awstypes.PromptFlowNodeSourceConfigurationMemberInline{
+			Value: apiObject,
+		}, diags
+	}
+
+	if !data.Resource.IsNull() {
+		var apiObject awstypes.PromptFlowNodeResourceConfiguration
+		resourceData, d := data.Resource.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		diags.Append(fwflex.Expand(ctx, resourceData, &apiObject)...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		return &awstypes.PromptFlowNodeSourceConfigurationMemberResource{
+			Value: apiObject,
+		}, diags
+	}
+
+	return nil, diags
+}
+
+func flattenPromptFlowNodeSourceConfiguration(ctx context.Context, apiObject awstypes.PromptFlowNodeSourceConfiguration) (fwtypes.ListNestedObjectValueOf[promptFlowNodeSourceConfigurationModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[promptFlowNodeSourceConfigurationModel](ctx), diags
+	}
+
+	data := promptFlowNodeSourceConfigurationModel{
+		Inline:   fwtypes.NewListNestedObjectValueOfNull[promptFlowNodeInlineConfigurationModel](ctx),
+		Resource: fwtypes.NewListNestedObjectValueOfNull[promptFlowNodeResourceConfigurationModel](ctx),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.PromptFlowNodeSourceConfigurationMemberInline:
+		var inlineData promptFlowNodeInlineConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &inlineData)...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[promptFlowNodeSourceConfigurationModel](ctx), diags
+		}
+
+		var d diag.Diagnostics
+		inlineData.InferenceConfiguration, d = flattenPromptInferenceConfiguration(ctx, v.Value.InferenceConfiguration)
+		diags.Append(d...)
+		inlineData.TemplateConfiguration, d = flattenPromptTemplateConfiguration(ctx, v.Value.TemplateConfiguration)
+		diags.Append(d...)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[promptFlowNodeSourceConfigurationModel](ctx), diags
+		}
+
+		data.Inline = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &inlineData)
+
+	case *awstypes.PromptFlowNodeSourceConfigurationMemberResource:
+		var resourceData promptFlowNodeResourceConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &resourceData)...)
+		data.Resource = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &resourceData)
+		if diags.HasError() {
+			return fwtypes.NewListNestedObjectValueOfNull[promptFlowNodeSourceConfigurationModel](ctx), diags
+		}
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data), diags
+}
+
+func expandFlowConnectionConfiguration(ctx context.Context, tfList fwtypes.ListNestedObjectValueOf[flowConnectionConfigurationModel]) (awstypes.FlowConnectionConfiguration, diag.Diagnostics) {
+	data, diags := tfList.ToPtr(ctx)
+	if diags.HasError() || data == nil {
+		return nil, diags
+	}
+
+	if !data.Conditional.IsNull() {
+		var apiObject awstypes.FlowConditionalConnectionConfiguration
+		conditionalData, d := data.Conditional.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		diags.Append(fwflex.Expand(ctx, conditionalData, &apiObject)...)
+
+		return &awstypes.FlowConnectionConfigurationMemberConditional{Value: apiObject}, diags
+	}
+
+	if !data.Data.IsNull() {
+		var apiObject awstypes.FlowDataConnectionConfiguration
+		dataData, d := data.Data.ToPtr(ctx)
+		diags.Append(d...)
+		if diags.HasError() {
+			return nil, diags
+		}
+
+		diags.Append(fwflex.Expand(ctx, dataData, &apiObject)...)
+
+		return &awstypes.FlowConnectionConfigurationMemberData{Value: apiObject}, diags
+	}
+
+	return nil, diags
+}
+
+func flattenFlowConnectionConfiguration(ctx context.Context, apiObject awstypes.FlowConnectionConfiguration) (fwtypes.ListNestedObjectValueOf[flowConnectionConfigurationModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if apiObject == nil {
+		return fwtypes.NewListNestedObjectValueOfNull[flowConnectionConfigurationModel](ctx), diags
+	}
+
+	data := flowConnectionConfigurationModel{
+		Conditional: fwtypes.NewListNestedObjectValueOfNull[flowConditionalConnectionConfigurationModel](ctx),
+		Data:        fwtypes.NewListNestedObjectValueOfNull[flowDataConnectionConfigurationModel](ctx),
+	}
+
+	switch v := apiObject.(type) {
+	case *awstypes.FlowConnectionConfigurationMemberConditional:
+		var conditionalData flowConditionalConnectionConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &conditionalData)...)
+		data.Conditional = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &conditionalData)
+
+	case *awstypes.FlowConnectionConfigurationMemberData:
+		var dataData flowDataConnectionConfigurationModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &dataData)...)
+		data.Data = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &dataData)
+	}
+
+	if diags.HasError() {
+		return fwtypes.NewListNestedObjectValueOfNull[flowConnectionConfigurationModel](ctx), diags
+	}
+
+	return fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &data), diags
+}
@@ -0,0 +1,13 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chatbot
+
+// Exports for use in tests only.
+var (
+	ResourceSlackChannelConfiguration = newSlackChannelConfigurationResource
+	ResourceTeamsChannelConfiguration = newTeamsChannelConfigurationResource
+
+	FindSlackChannelConfigurationByARN = findSlackChannelConfigurationByARN
+	FindTeamsChannelConfigurationByARN = findTeamsChannelConfigurationByARN
+)
@@ -0,0 +1,320 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chatbot_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/chatbot/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfchatbot "github.com/hashicorp/terraform-provider-aws/internal/service/chatbot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccChatbotSlackChannelConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SlackChannelConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_slack_channel_configuration.test"
+	teamID := acctest.SkipIfEnvVarNotSet(t, "CHATBOT_SLACK_TEAM_ID")
+	channelID := acctest.SkipIfEnvVarNotSet(t, "CHATBOT_SLACK_CHANNEL_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlackChannelConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackChannelConfigurationConfig_basic(rName, teamID, channelID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "chat_configuration_arn", "chatbot", regexache.MustCompile(`chat-configuration/slack-channel/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "configuration_name", rName),
+					resource.TestCheckResourceAttr(resourceName, "guardrail_policy_arns.#", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrIAMRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "logging_level", "NONE"),
+					resource.TestCheckResourceAttr(resourceName, "slack_channel_id", channelID),
+					resource.TestCheckResourceAttr(resourceName, "slack_team_id", teamID),
+					resource.TestCheckResourceAttrSet(resourceName, "slack_team_name"),
+					resource.TestCheckResourceAttr(resourceName, "sns_topic_arns.#", "0"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "user_authorization_required", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccChatbotSlackChannelConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SlackChannelConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_slack_channel_configuration.test"
+	teamID := acctest.SkipIfEnvVarNotSet(t, "CHATBOT_SLACK_TEAM_ID")
+	channelID := acctest.SkipIfEnvVarNotSet(t, "CHATBOT_SLACK_CHANNEL_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlackChannelConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackChannelConfigurationConfig_basic(rName, teamID, channelID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfchatbot.ResourceSlackChannelConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccChatbotSlackChannelConfiguration_update(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SlackChannelConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_slack_channel_configuration.test"
+	teamID := acctest.SkipIfEnvVarNotSet(t, "CHATBOT_SLACK_TEAM_ID")
+	channelID := acctest.SkipIfEnvVarNotSet(t, "CHATBOT_SLACK_CHANNEL_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlackChannelConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackChannelConfigurationConfig_full(rName, teamID, channelID, "ERROR", false),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "guardrail_policy_arns.#", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(resourceName, "guardrail_policy_arns.0", "aws_iam_policy.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "logging_level", "ERROR"),
+					resource.TestCheckResourceAttr(resourceName, "sns_topic_arns.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemAttrPair(resourceName, "sns_topic_arns.*", "aws_sns_topic.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "user_authorization_required", "false"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSlackChannelConfigurationConfig_full(rName, teamID, channelID, "INFO", true),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "logging_level", "INFO"),
+					resource.TestCheckResourceAttr(resourceName, "user_authorization_required", "true"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccChatbotSlackChannelConfiguration_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.SlackChannelConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_slack_channel_configuration.test"
+	teamID := acctest.SkipIfEnvVarNotSet(t, "CHATBOT_SLACK_TEAM_ID")
+	channelID := acctest.SkipIfEnvVarNotSet(t, "CHATBOT_SLACK_CHANNEL_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSlackChannelConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackChannelConfigurationConfig_tags1(rName, teamID, channelID, "key1", "value1"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccSlackChannelConfigurationConfig_tags2(rName, teamID, channelID, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccSlackChannelConfigurationConfig_tags1(rName, teamID, channelID, "key2", "value2"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckSlackChannelConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckSlackChannelConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChatbotClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_chatbot_slack_channel_configuration" {
+				continue
+			}
+
+			_, err := tfchatbot.FindSlackChannelConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Chatbot Slack Channel Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckSlackChannelConfigurationExists(ctx context.Context, n string, v *awstypes.SlackChannelConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChatbotClient(ctx)
+
+		output, err := tfchatbot.FindSlackChannelConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccChannelConfigurationConfig_base(rName string) string {
+	return fmt.Sprintf(`
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "chatbot.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccSlackChannelConfigurationConfig_basic(rName, teamID, channelID string) string {
+	return acctest.ConfigCompose(testAccChannelConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_chatbot_slack_channel_configuration" "test" {
+  configuration_name = %[1]q
+  iam_role_arn       = aws_iam_role.test.arn
+  slack_channel_id   = %[3]q
+  slack_team_id      = %[2]q
+}
+`, rName, teamID, channelID))
+}
+
+func testAccSlackChannelConfigurationConfig_full(rName, teamID, channelID, loggingLevel string, userAuthorizationRequired bool) string {
+	return acctest.ConfigCompose(testAccChannelConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_iam_policy" "test" {
+  name = %[1]q
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action   = "cloudwatch:Describe*"
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_chatbot_slack_channel_configuration" "test" {
+  configuration_name          = %[1]q
+  guardrail_policy_arns       = [aws_iam_policy.test.arn]
+  iam_role_arn                = aws_iam_role.test.arn
+  logging_level               = %[4]q
+  slack_channel_id            = %[3]q
+  slack_team_id               = %[2]q
+  sns_topic_arns              = [aws_sns_topic.test.arn]
+  user_authorization_required = %[5]t
+}
+`, rName, teamID, channelID, loggingLevel, userAuthorizationRequired))
+}
+
+func testAccSlackChannelConfigurationConfig_tags1(rName, teamID, channelID, tagKey1, tagValue1 string) string {
+	return acctest.ConfigCompose(testAccChannelConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_chatbot_slack_channel_configuration" "test" {
+  configuration_name = %[1]q
+  iam_role_arn       = aws_iam_role.test.arn
+  slack_channel_id   = %[3]q
+  slack_team_id      = %[2]q
+
+  tags = {
+    %[4]q = %[5]q
+  }
+}
+`, rName, teamID, channelID, tagKey1, tagValue1))
+}
+
+func testAccSlackChannelConfigurationConfig_tags2(rName, teamID, channelID, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return acctest.ConfigCompose(testAccChannelConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_chatbot_slack_channel_configuration" "test" {
+  configuration_name = %[1]q
+  iam_role_arn       = aws_iam_role.test.arn
+  slack_channel_id   = %[3]q
+  slack_team_id      = %[2]q
+
+  tags = {
+    %[4]q = %[5]q
+    %[6]q = %[7]q
+  }
+}
+`, rName, teamID, channelID, tagKey1, tagValue1, tagKey2, tagValue2))
+}
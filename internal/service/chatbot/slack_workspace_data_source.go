@@ -0,0 +1,109 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chatbot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/chatbot"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/chatbot/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkDataSource(name="Slack Workspace")
+func newSlackWorkspaceDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &slackWorkspaceDataSource{}
+
+	return d, nil
+}
+
+type slackWorkspaceDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*slackWorkspaceDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_chatbot_slack_workspace"
+}
+
+func (d *slackWorkspaceDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"slack_team_id": schema.StringAttribute{
+				Computed: true,
+			},
+			"slack_team_name": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *slackWorkspaceDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data slackWorkspaceDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ChatbotClient(ctx)
+
+	output, err := findSlackWorkspaceByName(ctx, conn, data.SlackTeamName.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Chatbot Slack Workspace (%s)", data.SlackTeamName.ValueString()), tfresource.SingularDataSourceFindError("Chatbot Slack Workspace", err).Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findSlackWorkspaceByName(ctx context.Context, conn *chatbot.Client, name string) (*awstypes.SlackWorkspace, error) {
+	input := &chatbot.DescribeSlackWorkspacesInput{}
+
+	output, err := findSlackWorkspaces(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(tfslices.Filter(output, func(v awstypes.SlackWorkspace) bool {
+		return aws.ToString(v.SlackTeamName) == name
+	}))
+}
+
+func findSlackWorkspaces(ctx context.Context, conn *chatbot.Client, input *chatbot.DescribeSlackWorkspacesInput) ([]awstypes.SlackWorkspace, error) {
+	var output []awstypes.SlackWorkspace
+
+	pages := chatbot.NewDescribeSlackWorkspacesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.SlackWorkspaces...)
+	}
+
+	return output, nil
+}
+
+type slackWorkspaceDataSourceModel struct {
+	SlackTeamID   types.String `tfsdk:"slack_team_id"`
+	SlackTeamName types.String `tfsdk:"slack_team_name"`
+}
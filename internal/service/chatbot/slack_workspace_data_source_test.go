@@ -0,0 +1,42 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chatbot_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccChatbotSlackWorkspaceDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_chatbot_slack_workspace.test"
+	teamName := acctest.SkipIfEnvVarNotSet(t, "CHATBOT_SLACK_TEAM_NAME")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSlackWorkspaceDataSourceConfig_basic(teamName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "slack_team_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "slack_team_name", teamName),
+				),
+			},
+		},
+	})
+}
+
+func testAccSlackWorkspaceDataSourceConfig_basic(teamName string) string {
+	return fmt.Sprintf(`
+data "aws_chatbot_slack_workspace" "test" {
+  slack_team_name = %[1]q
+}
+`, teamName)
+}
@@ -0,0 +1,82 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chatbot
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/chatbot"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	sweep.Register("aws_chatbot_slack_channel_configuration", sweepSlackChannelConfigurations)
+	sweep.Register("aws_chatbot_teams_channel_configuration", sweepTeamsChannelConfigurations)
+}
+
+func sweepSlackChannelConfigurations(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.ChatbotClient(ctx)
+	input := &chatbot.DescribeSlackChannelConfigurationsInput{}
+	var sweepResources []sweep.Sweepable
+
+	pages := chatbot.NewDescribeSlackChannelConfigurationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+				"error": err.Error(),
+			})
+			return nil, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.SlackChannelConfigurations {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newSlackChannelConfigurationResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.ChatConfigurationArn)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepTeamsChannelConfigurations(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.ChatbotClient(ctx)
+	input := &chatbot.ListMicrosoftTeamsChannelConfigurationsInput{}
+	var sweepResources []sweep.Sweepable
+
+	pages := chatbot.NewListMicrosoftTeamsChannelConfigurationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+				"error": err.Error(),
+			})
+			return nil, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.TeamChannelConfigurations {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newTeamsChannelConfigurationResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.ChatConfigurationArn)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -0,0 +1,153 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chatbot_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/chatbot/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfchatbot "github.com/hashicorp/terraform-provider-aws/internal/service/chatbot"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccChatbotTeamsChannelConfiguration_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.TeamsChannelConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_teams_channel_configuration.test"
+	tenantID := acctest.SkipIfEnvVarNotSet(t, "CHATBOT_TEAMS_TENANT_ID")
+	teamID := acctest.SkipIfEnvVarNotSet(t, "CHATBOT_TEAMS_TEAM_ID")
+	channelID := acctest.SkipIfEnvVarNotSet(t, "CHATBOT_TEAMS_CHANNEL_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTeamsChannelConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamsChannelConfigurationConfig_basic(rName, tenantID, teamID, channelID, "ERROR"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTeamsChannelConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "channel_id", channelID),
+					acctest.MatchResourceAttrGlobalARN(resourceName, "chat_configuration_arn", "chatbot", regexache.MustCompile(`chat-configuration/.+`)),
+					resource.TestCheckResourceAttr(resourceName, "configuration_name", rName),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrIAMRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "logging_level", "ERROR"),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "team_id", teamID),
+					resource.TestCheckResourceAttr(resourceName, "tenant_id", tenantID),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTeamsChannelConfigurationConfig_basic(rName, tenantID, teamID, channelID, "INFO"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTeamsChannelConfigurationExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "logging_level", "INFO"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccChatbotTeamsChannelConfiguration_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v awstypes.TeamsChannelConfiguration
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_chatbot_teams_channel_configuration.test"
+	tenantID := acctest.SkipIfEnvVarNotSet(t, "CHATBOT_TEAMS_TENANT_ID")
+	teamID := acctest.SkipIfEnvVarNotSet(t, "CHATBOT_TEAMS_TEAM_ID")
+	channelID := acctest.SkipIfEnvVarNotSet(t, "CHATBOT_TEAMS_CHANNEL_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTeamsChannelConfigurationDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamsChannelConfigurationConfig_basic(rName, tenantID, teamID, channelID, "ERROR"),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTeamsChannelConfigurationExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfchatbot.ResourceTeamsChannelConfiguration, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckTeamsChannelConfigurationDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChatbotClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_chatbot_teams_channel_configuration" {
+				continue
+			}
+
+			_, err := tfchatbot.FindTeamsChannelConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Chatbot Microsoft Teams Channel Configuration %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTeamsChannelConfigurationExists(ctx context.Context, n string, v *awstypes.TeamsChannelConfiguration) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ChatbotClient(ctx)
+
+		output, err := tfchatbot.FindTeamsChannelConfigurationByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTeamsChannelConfigurationConfig_basic(rName, tenantID, teamID, channelID, loggingLevel string) string {
+	return acctest.ConfigCompose(testAccChannelConfigurationConfig_base(rName), fmt.Sprintf(`
+resource "aws_chatbot_teams_channel_configuration" "test" {
+  channel_id         = %[4]q
+  configuration_name = %[1]q
+  iam_role_arn       = aws_iam_role.test.arn
+  logging_level      = %[5]q
+  team_id            = %[3]q
+  tenant_id          = %[2]q
+}
+`, rName, tenantID, teamID, channelID, loggingLevel))
+}
@@ -0,0 +1,122 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chatbot
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/chatbot"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/chatbot/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkDataSource(name="Teams Tenant")
+func newTeamsTenantDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &teamsTenantDataSource{}
+
+	return d, nil
+}
+
+type teamsTenantDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*teamsTenantDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_chatbot_teams_tenant"
+}
+
+func (d *teamsTenantDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"teams": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[configuredTeamModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[configuredTeamModel](ctx),
+				Computed:    true,
+			},
+			"tenant_id": schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *teamsTenantDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data teamsTenantDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().ChatbotClient(ctx)
+
+	tenantID := data.TenantID.ValueString()
+	output, err := findConfiguredTeamsByTenantID(ctx, conn, tenantID)
+
+	if err == nil && len(output) == 0 {
+		err = tfresource.NewEmptyResultError(tenantID)
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Chatbot Teams Tenant (%s)", tenantID), tfresource.SingularDataSourceFindError("Chatbot Teams Tenant", err).Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data.Teams)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findConfiguredTeamsByTenantID(ctx context.Context, conn *chatbot.Client, tenantID string) ([]awstypes.ConfiguredTeam, error) {
+	input := &chatbot.ListMicrosoftTeamsConfiguredTeamsInput{}
+
+	output, err := findConfiguredTeams(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfslices.Filter(output, func(v awstypes.ConfiguredTeam) bool {
+		return aws.ToString(v.TenantId) == tenantID
+	}), nil
+}
+
+func findConfiguredTeams(ctx context.Context, conn *chatbot.Client, input *chatbot.ListMicrosoftTeamsConfiguredTeamsInput) ([]awstypes.ConfiguredTeam, error) {
+	var output []awstypes.ConfiguredTeam
+
+	pages := chatbot.NewListMicrosoftTeamsConfiguredTeamsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ConfiguredTeams...)
+	}
+
+	return output, nil
+}
+
+type teamsTenantDataSourceModel struct {
+	Teams    fwtypes.ListNestedObjectValueOf[configuredTeamModel] `tfsdk:"teams"`
+	TenantID types.String                                         `tfsdk:"tenant_id"`
+}
+
+type configuredTeamModel struct {
+	TeamID   types.String `tfsdk:"team_id"`
+	TeamName types.String `tfsdk:"team_name"`
+}
@@ -0,0 +1,43 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package chatbot_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccChatbotTeamsTenantDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_chatbot_teams_tenant.test"
+	tenantID := acctest.SkipIfEnvVarNotSet(t, "CHATBOT_TEAMS_TENANT_ID")
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ChatbotServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTeamsTenantDataSourceConfig_basic(tenantID),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttrSet(dataSourceName, "teams.#"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "teams.0.team_id"),
+					resource.TestCheckResourceAttr(dataSourceName, "tenant_id", tenantID),
+				),
+			},
+		},
+	})
+}
+
+func testAccTeamsTenantDataSourceConfig_basic(tenantID string) string {
+	return fmt.Sprintf(`
+data "aws_chatbot_teams_tenant" "test" {
+  tenant_id = %[1]q
+}
+`, tenantID)
+}
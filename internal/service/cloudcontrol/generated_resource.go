@@ -0,0 +1,369 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/cloudcontrol"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/cloudcontrol/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// GeneratedResourceDefinition describes a resource generated from a CloudFormation resource schema
+// by internal/generate/cloudformation. Such resources are managed using the Cloud Control API.
+type GeneratedResourceDefinition struct {
+	// CloudFormation resource type name, e.g. "AWS::IoT::JobTemplate".
+	CFTypeName string
+	// Terraform resource type name, e.g. "aws_iot_job_template".
+	TFTypeName string
+	// Terraform resource schema. The "id" attribute holds the Cloud Control API resource identifier.
+	Schema schema.Schema
+	// Map of Terraform attribute name to CloudFormation property name, at all levels of nesting.
+	AttributeNames map[string]string
+	// JSON Pointers to properties that are never sent to the Cloud Control API.
+	ReadOnlyProperties []string
+	// JSON Pointers to properties that are never returned by the Cloud Control API.
+	// Their values are preserved from configuration.
+	WriteOnlyProperties []string
+}
+
+// NewGeneratedResource returns a new resource from the specified definition.
+func NewGeneratedResource(definition GeneratedResourceDefinition) resource.ResourceWithConfigure {
+	r := &generatedResource{
+		definition: definition,
+	}
+
+	r.SetDefaultCreateTimeout(2 * time.Hour)
+	r.SetDefaultUpdateTimeout(2 * time.Hour)
+	r.SetDefaultDeleteTimeout(2 * time.Hour)
+
+	return r
+}
+
+type generatedResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithTimeouts
+
+	definition GeneratedResourceDefinition
+}
+
+func (r *generatedResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = r.definition.TFTypeName
+}
+
+func (r *generatedResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = r.definition.Schema
+}
+
+func (r *generatedResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	conn := r.Meta().CloudControlClient(ctx)
+
+	var timeouts timeouts.Value
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrTimeouts), &timeouts)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	typeName := r.definition.CFTypeName
+	desiredState, err := r.desiredState(ctx, request.Plan.Schema.Type(), request.Plan.Raw)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Cloud Control API (%s) Resource", typeName), err.Error())
+
+		return
+	}
+
+	input := &cloudcontrol.CreateResourceInput{
+		ClientToken:  aws.String(sdkid.UniqueId()),
+		DesiredState: aws.String(desiredState),
+		TypeName:     aws.String(typeName),
+	}
+
+	output, err := conn.CreateResource(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Cloud Control API (%s) Resource", typeName), err.Error())
+
+		return
+	}
+
+	id := aws.ToString(output.ProgressEvent.Identifier)
+	progressEvent, err := waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), r.CreateTimeout(ctx, timeouts))
+
+	// Some resources do not set the identifier until after creation.
+	if id == "" && progressEvent != nil {
+		id = aws.ToString(progressEvent.Identifier)
+	}
+
+	if err != nil {
+		if id != "" {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+		}
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Cloud Control API (%s) Resource (%s) create", typeName, id), err.Error())
+
+		return
+	}
+
+	state, err := r.read(ctx, conn, id, request.Plan.Schema.Type(), request.Plan.Raw)
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), id) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Cloud Control API (%s) Resource (%s)", typeName, id), err.Error())
+
+		return
+	}
+
+	response.State.Raw = state
+}
+
+func (r *generatedResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	conn := r.Meta().CloudControlClient(ctx)
+
+	var id types.String
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrID), &id)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	typeName := r.definition.CFTypeName
+	state, err := r.read(ctx, conn, id.ValueString(), request.State.Schema.Type(), request.State.Raw)
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Cloud Control API (%s) Resource (%s)", typeName, id.ValueString()), err.Error())
+
+		return
+	}
+
+	response.State.Raw = state
+}
+
+func (r *generatedResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	conn := r.Meta().CloudControlClient(ctx)
+
+	var id types.String
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrID), &id)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var timeouts timeouts.Value
+	response.Diagnostics.Append(request.Plan.GetAttribute(ctx, path.Root(names.AttrTimeouts), &timeouts)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	typeName := r.definition.CFTypeName
+	oldState, err := r.desiredState(ctx, request.State.Schema.Type(), request.State.Raw)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Cloud Control API (%s) Resource (%s)", typeName, id.ValueString()), err.Error())
+
+		return
+	}
+
+	newState, err := r.desiredState(ctx, request.Plan.Schema.Type(), request.Plan.Raw)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Cloud Control API (%s) Resource (%s)", typeName, id.ValueString()), err.Error())
+
+		return
+	}
+
+	// The desired state is unchanged if only attributes with no corresponding property, e.g. timeouts, have changed.
+	if newState != oldState {
+		patchDocument, err := patchDocument(oldState, newState)
+
+		if err != nil {
+			response.Diagnostics.AddError("creating JSON Patch", err.Error())
+
+			return
+		}
+
+		input := &cloudcontrol.UpdateResourceInput{
+			ClientToken:   aws.String(sdkid.UniqueId()),
+			Identifier:    aws.String(id.ValueString()),
+			PatchDocument: aws.String(patchDocument),
+			TypeName:      aws.String(typeName),
+		}
+
+		output, err := conn.UpdateResource(ctx, input)
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Cloud Control API (%s) Resource (%s)", typeName, id.ValueString()), err.Error())
+
+			return
+		}
+
+		if _, err := waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), r.UpdateTimeout(ctx, timeouts)); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("waiting for Cloud Control API (%s) Resource (%s) update", typeName, id.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	state, err := r.read(ctx, conn, id.ValueString(), request.Plan.Schema.Type(), request.Plan.Raw)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Cloud Control API (%s) Resource (%s)", typeName, id.ValueString()), err.Error())
+
+		return
+	}
+
+	response.State.Raw = state
+}
+
+func (r *generatedResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	conn := r.Meta().CloudControlClient(ctx)
+
+	var id types.String
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrID), &id)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	var timeouts timeouts.Value
+	response.Diagnostics.Append(request.State.GetAttribute(ctx, path.Root(names.AttrTimeouts), &timeouts)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	typeName := r.definition.CFTypeName
+	output, err := conn.DeleteResource(ctx, &cloudcontrol.DeleteResourceInput{
+		ClientToken: aws.String(sdkid.UniqueId()),
+		Identifier:  aws.String(id.ValueString()),
+		TypeName:    aws.String(typeName),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Cloud Control API (%s) Resource (%s)", typeName, id.ValueString()), err.Error())
+
+		return
+	}
+
+	progressEvent, err := waitProgressEventOperationStatusSuccess(ctx, conn, aws.ToString(output.ProgressEvent.RequestToken), r.DeleteTimeout(ctx, timeouts))
+
+	if progressEvent != nil && progressEvent.ErrorCode == awstypes.HandlerErrorCodeNotFound {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("waiting for Cloud Control API (%s) Resource (%s) delete", typeName, id.ValueString()), err.Error())
+
+		return
+	}
+}
+
+// desiredState returns the Cloud Control API desired state corresponding to the specified Terraform value.
+func (r *generatedResource) desiredState(ctx context.Context, t attr.Type, v tftypes.Value) (string, error) {
+	properties, err := expandProperties(ctx, t, v, r.definition.AttributeNames)
+
+	if err != nil {
+		return "", err
+	}
+
+	for _, v := range r.definition.ReadOnlyProperties {
+		removeProperty(properties, v)
+	}
+
+	b, err := json.Marshal(properties)
+
+	if err != nil {
+		return "", err
+	}
+
+	return string(b), nil
+}
+
+// read returns the Terraform value corresponding to the resource's current properties.
+// Values not returned by the Cloud Control API are taken from the prior Terraform value.
+func (r *generatedResource) read(ctx context.Context, conn *cloudcontrol.Client, id string, t attr.Type, prior tftypes.Value) (tftypes.Value, error) {
+	resourceDescription, err := findResource(ctx, conn, id, r.definition.CFTypeName, "", "")
+
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	var properties map[string]any
+	decoder := json.NewDecoder(bytes.NewBufferString(aws.ToString(resourceDescription.Properties)))
+	decoder.UseNumber()
+	if err := decoder.Decode(&properties); err != nil {
+		return tftypes.Value{}, fmt.Errorf("decoding properties: %w", err)
+	}
+
+	priorProperties, err := expandProperties(ctx, t, prior, r.definition.AttributeNames)
+
+	if err != nil {
+		return tftypes.Value{}, err
+	}
+
+	for _, v := range r.definition.WriteOnlyProperties {
+		copyProperty(priorProperties, properties, v)
+	}
+
+	v, err := flattenProperties(ctx, t, properties, r.definition.AttributeNames)
+
+	if err != nil {
+		return tftypes.Value{}, fmt.Errorf("flattening properties: %w", err)
+	}
+
+	var values, priorValues map[string]tftypes.Value
+	if err := v.As(&values); err != nil {
+		return tftypes.Value{}, err
+	}
+	if prior.IsKnown() && !prior.IsNull() {
+		if err := prior.As(&priorValues); err != nil {
+			return tftypes.Value{}, err
+		}
+	}
+
+	// Attributes not corresponding to properties, e.g. timeouts.
+	for name := range values {
+		if _, ok := r.definition.AttributeNames[name]; ok {
+			continue
+		}
+
+		if v, ok := priorValues[name]; ok {
+			values[name] = v
+		}
+	}
+	values[names.AttrID] = tftypes.NewValue(tftypes.String, id)
+
+	return tftypes.NewValue(v.Type(), values), nil
+}
+
+// FindGeneratedResourceByID returns the current state of the resource of the specified CloudFormation type.
+// It is used by the acceptance tests of generated resources in other service packages.
+func FindGeneratedResourceByID(ctx context.Context, conn *cloudcontrol.Client, id, typeName string) (*awstypes.ResourceDescription, error) {
+	return findResource(ctx, conn, id, typeName, "", "")
+}
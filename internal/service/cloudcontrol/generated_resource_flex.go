@@ -0,0 +1,411 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math/big"
+	"strconv"
+
+	cfschema "github.com/hashicorp/aws-cloudformation-resource-schema-sdk-go"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// expandProperties returns the CloudFormation resource properties corresponding to the specified Terraform resource value.
+// Null and unknown values, the "id" attribute and attributes with no corresponding property are omitted.
+func expandProperties(ctx context.Context, t attr.Type, v tftypes.Value, attributeNames map[string]string) (map[string]any, error) {
+	result := make(map[string]any)
+
+	if !v.IsKnown() || v.IsNull() {
+		return result, nil
+	}
+
+	tObject, ok := t.(attr.TypeWithAttributeTypes)
+	if !ok {
+		return nil, fmt.Errorf("unexpected resource type: %T", t)
+	}
+
+	var values map[string]tftypes.Value
+	if err := v.As(&values); err != nil {
+		return nil, err
+	}
+
+	for name, t := range tObject.AttributeTypes() {
+		if name == names.AttrID {
+			continue
+		}
+
+		propertyName, ok := attributeNames[name]
+		if !ok {
+			continue
+		}
+
+		property, err := expandProperty(ctx, t, values[name], attributeNames)
+		if err != nil {
+			return nil, fmt.Errorf("%s: %w", name, err)
+		}
+
+		if property != nil {
+			result[propertyName] = property
+		}
+	}
+
+	return result, nil
+}
+
+func expandProperty(ctx context.Context, t attr.Type, v tftypes.Value, attributeNames map[string]string) (any, error) {
+	if !v.IsKnown() || v.IsNull() {
+		return nil, nil
+	}
+
+	switch t := t.(type) {
+	case jsontypes.NormalizedType:
+		var s string
+		if err := v.As(&s); err != nil {
+			return nil, err
+		}
+
+		var document any
+		if err := json.Unmarshal([]byte(s), &document); err != nil {
+			return nil, err
+		}
+
+		return document, nil
+
+	case attr.TypeWithAttributeTypes:
+		var values map[string]tftypes.Value
+		if err := v.As(&values); err != nil {
+			return nil, err
+		}
+
+		result := make(map[string]any)
+		for name, t := range t.AttributeTypes() {
+			propertyName, ok := attributeNames[name]
+			if !ok {
+				continue
+			}
+
+			property, err := expandProperty(ctx, t, values[name], attributeNames)
+			if err != nil {
+				return nil, fmt.Errorf("%s: %w", name, err)
+			}
+
+			if property != nil {
+				result[propertyName] = property
+			}
+		}
+
+		return result, nil
+
+	case attr.TypeWithElementType:
+		if v.Type().Is(tftypes.Map{}) {
+			var values map[string]tftypes.Value
+			if err := v.As(&values); err != nil {
+				return nil, err
+			}
+
+			result := make(map[string]any, len(values))
+			for key, v := range values {
+				property, err := expandProperty(ctx, t.ElementType(), v, attributeNames)
+				if err != nil {
+					return nil, fmt.Errorf("%s: %w", key, err)
+				}
+
+				result[key] = property
+			}
+
+			return result, nil
+		}
+
+		var values []tftypes.Value
+		if err := v.As(&values); err != nil {
+			return nil, err
+		}
+
+		result := make([]any, 0, len(values))
+		for i, v := range values {
+			property, err := expandProperty(ctx, t.ElementType(), v, attributeNames)
+			if err != nil {
+				return nil, fmt.Errorf("[%d]: %w", i, err)
+			}
+
+			result = append(result, property)
+		}
+
+		return result, nil
+	}
+
+	switch typ := v.Type(); {
+	case typ.Is(tftypes.Bool):
+		var b bool
+		if err := v.As(&b); err != nil {
+			return nil, err
+		}
+
+		return b, nil
+
+	case typ.Is(tftypes.Number):
+		var f big.Float
+		if err := v.As(&f); err != nil {
+			return nil, err
+		}
+
+		if f.IsInt() {
+			i, _ := f.Int64()
+			return i, nil
+		}
+
+		f64, _ := f.Float64()
+		return f64, nil
+
+	case typ.Is(tftypes.String):
+		var s string
+		if err := v.As(&s); err != nil {
+			return nil, err
+		}
+
+		return s, nil
+
+	default:
+		return nil, fmt.Errorf("unsupported type: %s", typ)
+	}
+}
+
+// flattenProperties returns the Terraform resource value corresponding to the specified CloudFormation resource properties.
+// The "id" attribute and attributes with no corresponding property are null.
+func flattenProperties(ctx context.Context, t attr.Type, properties map[string]any, attributeNames map[string]string) (tftypes.Value, error) {
+	tObject, ok := t.(attr.TypeWithAttributeTypes)
+	if !ok {
+		return tftypes.Value{}, fmt.Errorf("unexpected resource type: %T", t)
+	}
+
+	values := make(map[string]tftypes.Value)
+	for name, t := range tObject.AttributeTypes() {
+		var property any
+		if propertyName, ok := attributeNames[name]; ok && name != names.AttrID {
+			property = properties[propertyName]
+		}
+
+		v, err := flattenProperty(ctx, t, property, attributeNames)
+		if err != nil {
+			return tftypes.Value{}, fmt.Errorf("%s: %w", name, err)
+		}
+
+		values[name] = v
+	}
+
+	return tftypes.NewValue(t.TerraformType(ctx), values), nil
+}
+
+func flattenProperty(ctx context.Context, t attr.Type, property any, attributeNames map[string]string) (tftypes.Value, error) {
+	typ := t.TerraformType(ctx)
+
+	if property == nil {
+		return tftypes.NewValue(typ, nil), nil
+	}
+
+	switch t := t.(type) {
+	case jsontypes.NormalizedType:
+		b, err := json.Marshal(property)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		return tftypes.NewValue(typ, string(b)), nil
+
+	case attr.TypeWithAttributeTypes:
+		m, ok := property.(map[string]any)
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("unexpected object type: %T", property)
+		}
+
+		values := make(map[string]tftypes.Value)
+		for name, t := range t.AttributeTypes() {
+			var property any
+			if propertyName, ok := attributeNames[name]; ok {
+				property = m[propertyName]
+			}
+
+			v, err := flattenProperty(ctx, t, property, attributeNames)
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("%s: %w", name, err)
+			}
+
+			values[name] = v
+		}
+
+		return tftypes.NewValue(typ, values), nil
+
+	case attr.TypeWithElementType:
+		if typ.Is(tftypes.Map{}) {
+			m, ok := property.(map[string]any)
+			if !ok {
+				return tftypes.Value{}, fmt.Errorf("unexpected map type: %T", property)
+			}
+
+			values := make(map[string]tftypes.Value, len(m))
+			for key, property := range m {
+				v, err := flattenProperty(ctx, t.ElementType(), property, attributeNames)
+				if err != nil {
+					return tftypes.Value{}, fmt.Errorf("%s: %w", key, err)
+				}
+
+				values[key] = v
+			}
+
+			return tftypes.NewValue(typ, values), nil
+		}
+
+		s, ok := property.([]any)
+		if !ok {
+			return tftypes.Value{}, fmt.Errorf("unexpected list type: %T", property)
+		}
+
+		values := make([]tftypes.Value, 0, len(s))
+		for i, property := range s {
+			v, err := flattenProperty(ctx, t.ElementType(), property, attributeNames)
+			if err != nil {
+				return tftypes.Value{}, fmt.Errorf("[%d]: %w", i, err)
+			}
+
+			values = append(values, v)
+		}
+
+		return tftypes.NewValue(typ, values), nil
+	}
+
+	switch {
+	case typ.Is(tftypes.Bool):
+		switch v := property.(type) {
+		case bool:
+			return tftypes.NewValue(typ, v), nil
+		case string:
+			b, err := strconv.ParseBool(v)
+			if err != nil {
+				return tftypes.Value{}, err
+			}
+
+			return tftypes.NewValue(typ, b), nil
+		}
+
+	case typ.Is(tftypes.Number):
+		var s string
+		switch v := property.(type) {
+		case json.Number:
+			s = v.String()
+		case string:
+			s = v
+		case float64:
+			return tftypes.NewValue(typ, big.NewFloat(v)), nil
+		default:
+			return tftypes.Value{}, fmt.Errorf("unexpected number type: %T", property)
+		}
+
+		f, _, err := big.ParseFloat(s, 10, 512, big.ToNearestEven)
+		if err != nil {
+			return tftypes.Value{}, err
+		}
+
+		return tftypes.NewValue(typ, f), nil
+
+	case typ.Is(tftypes.String):
+		switch v := property.(type) {
+		case string:
+			return tftypes.NewValue(typ, v), nil
+		case json.Number, bool, float64:
+			// Some resources return scalar values for string properties.
+			return tftypes.NewValue(typ, fmt.Sprint(v)), nil
+		}
+	}
+
+	return tftypes.Value{}, fmt.Errorf("unexpected %s type: %T", typ, property)
+}
+
+// removeProperty removes the property at the specified JSON Pointer.
+func removeProperty(properties map[string]any, pointer string) {
+	p := cfschema.PropertyJsonPointer(pointer)
+
+	removePropertyPath(properties, p.Path())
+}
+
+func removePropertyPath(v any, path []string) {
+	if len(path) == 0 {
+		return
+	}
+
+	switch v := v.(type) {
+	case map[string]any:
+		if len(path) == 1 {
+			delete(v, path[0])
+
+			return
+		}
+
+		removePropertyPath(v[path[0]], path[1:])
+
+	case []any:
+		if path[0] != "*" {
+			return
+		}
+
+		for _, v := range v {
+			removePropertyPath(v, path[1:])
+		}
+	}
+}
+
+// copyProperty copies the property at the specified JSON Pointer, if any.
+func copyProperty(from, to map[string]any, pointer string) {
+	p := cfschema.PropertyJsonPointer(pointer)
+
+	copyPropertyPath(from, to, p.Path())
+}
+
+func copyPropertyPath(from, to any, path []string) {
+	if len(path) == 0 {
+		return
+	}
+
+	switch from := from.(type) {
+	case map[string]any:
+		to, ok := to.(map[string]any)
+		if !ok {
+			return
+		}
+
+		v, ok := from[path[0]]
+		if !ok {
+			return
+		}
+
+		if len(path) == 1 {
+			to[path[0]] = v
+
+			return
+		}
+
+		if _, ok := to[path[0]]; !ok {
+			if _, ok := v.(map[string]any); ok {
+				to[path[0]] = make(map[string]any)
+			}
+		}
+
+		copyPropertyPath(v, to[path[0]], path[1:])
+
+	case []any:
+		to, ok := to.([]any)
+		if !ok || path[0] != "*" || len(from) != len(to) {
+			return
+		}
+
+		for i := range from {
+			copyPropertyPath(from[i], to[i], path[1:])
+		}
+	}
+}
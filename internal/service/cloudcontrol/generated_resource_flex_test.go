@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cloudcontrol
+
+import (
+	"context"
+	"encoding/json"
+	"math/big"
+	"testing"
+
+	"github.com/google/go-cmp/cmp"
+	"github.com/hashicorp/terraform-plugin-framework-jsontypes/jsontypes"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-go/tftypes"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestGeneratedResourceProperties(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: schema.StringAttribute{
+				Computed: true,
+			},
+			"thing_id": schema.StringAttribute{
+				Required: true,
+			},
+			"count": schema.Int64Attribute{
+				Optional: true,
+			},
+			"ratio": schema.Float64Attribute{
+				Optional: true,
+			},
+			names.AttrEnabled: schema.BoolAttribute{
+				Optional: true,
+			},
+			"config": schema.SingleNestedAttribute{
+				Optional: true,
+				Attributes: map[string]schema.Attribute{
+					names.AttrID: schema.StringAttribute{
+						Optional: true,
+					},
+					names.AttrValues: schema.SetAttribute{
+						ElementType: types.StringType,
+						Optional:    true,
+					},
+				},
+			},
+			"rules": schema.ListNestedAttribute{
+				Optional: true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+			"labels": schema.MapAttribute{
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"document": schema.StringAttribute{
+				CustomType: jsontypes.NormalizedType{},
+				Optional:   true,
+			},
+			"unmapped": schema.StringAttribute{
+				Optional: true,
+			},
+		},
+	}
+	attributeNames := map[string]string{
+		"thing_id":        "Id",
+		"count":           "Count",
+		"ratio":           "Ratio",
+		names.AttrEnabled: "Enabled",
+		"config":          "Config",
+		names.AttrID:      "Id",
+		names.AttrValues:  "Values",
+		"rules":           "Rules",
+		names.AttrName:    "Name",
+		"labels":          "Labels",
+		"document":        "Document",
+	}
+
+	typ := resourceSchema.Type().TerraformType(ctx).(tftypes.Object)
+	configType := typ.AttributeTypes["config"]
+	ruleType := typ.AttributeTypes["rules"].(tftypes.List).ElementType
+
+	value := tftypes.NewValue(typ, map[string]tftypes.Value{
+		names.AttrID:      tftypes.NewValue(tftypes.String, "thing-1"),
+		"thing_id":        tftypes.NewValue(tftypes.String, "thing-1"),
+		"count":           tftypes.NewValue(tftypes.Number, 3),
+		"ratio":           tftypes.NewValue(tftypes.Number, big.NewFloat(0.5)),
+		names.AttrEnabled: tftypes.NewValue(tftypes.Bool, true),
+		"config": tftypes.NewValue(configType, map[string]tftypes.Value{
+			names.AttrID: tftypes.NewValue(tftypes.String, "config-1"),
+			names.AttrValues: tftypes.NewValue(tftypes.Set{ElementType: tftypes.String}, []tftypes.Value{
+				tftypes.NewValue(tftypes.String, "a"),
+			}),
+		}),
+		"rules": tftypes.NewValue(typ.AttributeTypes["rules"], []tftypes.Value{
+			tftypes.NewValue(ruleType, map[string]tftypes.Value{
+				names.AttrName: tftypes.NewValue(tftypes.String, "rule-1"),
+			}),
+			tftypes.NewValue(ruleType, map[string]tftypes.Value{
+				names.AttrName: tftypes.NewValue(tftypes.String, "rule-2"),
+			}),
+		}),
+		"labels": tftypes.NewValue(tftypes.Map{ElementType: tftypes.String}, map[string]tftypes.Value{
+			"k1": tftypes.NewValue(tftypes.String, "v1"),
+		}),
+		"document": tftypes.NewValue(tftypes.String, `{"Items":[1,2],"Version":"1"}`),
+		"unmapped": tftypes.NewValue(tftypes.String, "ignored"),
+	})
+
+	got, err := expandProperties(ctx, resourceSchema.Type(), value, attributeNames)
+	if err != nil {
+		t.Fatalf("expanding properties: %s", err)
+	}
+
+	want := map[string]any{
+		"Id":      "thing-1",
+		"Count":   int64(3),
+		"Ratio":   0.5,
+		"Enabled": true,
+		"Config": map[string]any{
+			"Id":     "config-1",
+			"Values": []any{"a"},
+		},
+		"Rules": []any{
+			map[string]any{"Name": "rule-1"},
+			map[string]any{"Name": "rule-2"},
+		},
+		"Labels": map[string]any{
+			"k1": "v1",
+		},
+		"Document": map[string]any{
+			"Version": "1",
+			"Items":   []any{float64(1), float64(2)},
+		},
+	}
+
+	if diff := cmp.Diff(got, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+
+	// Round trip via JSON, as returned by the Cloud Control API.
+	b, err := json.Marshal(got)
+	if err != nil {
+		t.Fatal(err)
+	}
+	var properties map[string]any
+	if err := json.Unmarshal(b, &properties); err != nil {
+		t.Fatal(err)
+	}
+
+	flattened, err := flattenProperties(ctx, resourceSchema.Type(), properties, attributeNames)
+	if err != nil {
+		t.Fatalf("flattening properties: %s", err)
+	}
+
+	var values map[string]tftypes.Value
+	if err := flattened.As(&values); err != nil {
+		t.Fatal(err)
+	}
+
+	// The "id" attribute and attributes with no corresponding property are null.
+	for _, name := range []string{names.AttrID, "unmapped"} {
+		if !values[name].IsNull() {
+			t.Errorf("%s: expected null, got %s", name, values[name])
+		}
+	}
+
+	for _, name := range []string{"thing_id", "count", "ratio", names.AttrEnabled, "config", "rules", "labels", "document"} {
+		var wantValues map[string]tftypes.Value
+		if err := value.As(&wantValues); err != nil {
+			t.Fatal(err)
+		}
+
+		if !values[name].Equal(wantValues[name]) {
+			t.Errorf("%s: got %s, want %s", name, values[name], wantValues[name])
+		}
+	}
+}
+
+func TestGeneratedResourcePropertiesUnknown(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	resourceSchema := schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrARN: schema.StringAttribute{
+				Computed: true,
+			},
+		},
+	}
+	attributeNames := map[string]string{
+		names.AttrName: "Name",
+		names.AttrARN:  "Arn",
+	}
+
+	typ := resourceSchema.Type().TerraformType(ctx)
+	value := tftypes.NewValue(typ, map[string]tftypes.Value{
+		names.AttrName: tftypes.NewValue(tftypes.String, "test"),
+		names.AttrARN:  tftypes.NewValue(tftypes.String, tftypes.UnknownValue),
+	})
+
+	got, err := expandProperties(ctx, resourceSchema.Type(), value, attributeNames)
+	if err != nil {
+		t.Fatalf("expanding properties: %s", err)
+	}
+
+	if diff := cmp.Diff(got, map[string]any{"Name": "test"}); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestRemoveProperty(t *testing.T) {
+	t.Parallel()
+
+	properties := map[string]any{
+		"Arn":  "arn",
+		"Name": "name",
+		"Config": map[string]any{
+			"Id":   "id",
+			"Mode": "mode",
+		},
+		"Rules": []any{
+			map[string]any{"Id": "1", "Name": "rule-1"},
+			map[string]any{"Id": "2", "Name": "rule-2"},
+		},
+	}
+
+	for _, v := range []string{"/properties/Arn", "/properties/Config/Id", "/properties/Rules/*/Id", "/properties/Missing/Id"} {
+		removeProperty(properties, v)
+	}
+
+	want := map[string]any{
+		"Name": "name",
+		"Config": map[string]any{
+			"Mode": "mode",
+		},
+		"Rules": []any{
+			map[string]any{"Name": "rule-1"},
+			map[string]any{"Name": "rule-2"},
+		},
+	}
+
+	if diff := cmp.Diff(properties, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
+
+func TestCopyProperty(t *testing.T) {
+	t.Parallel()
+
+	from := map[string]any{
+		"Password": "secret",
+		"Config": map[string]any{
+			"Token": "token",
+		},
+		"Rules": []any{
+			map[string]any{"Key": "k1"},
+			map[string]any{"Key": "k2"},
+		},
+	}
+	to := map[string]any{
+		"Name": "name",
+		"Rules": []any{
+			map[string]any{"Name": "rule-1"},
+			map[string]any{"Name": "rule-2"},
+		},
+	}
+
+	for _, v := range []string{"/properties/Password", "/properties/Config/Token", "/properties/Rules/*/Key", "/properties/Missing"} {
+		copyProperty(from, to, v)
+	}
+
+	want := map[string]any{
+		"Name":     "name",
+		"Password": "secret",
+		"Config": map[string]any{
+			"Token": "token",
+		},
+		"Rules": []any{
+			map[string]any{"Key": "k1", "Name": "rule-1"},
+			map[string]any{"Key": "k2", "Name": "rule-2"},
+		},
+	}
+
+	if diff := cmp.Diff(to, want); diff != "" {
+		t.Errorf("unexpected diff (+wanted, -got): %s", diff)
+	}
+}
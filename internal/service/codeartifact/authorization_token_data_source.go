@@ -20,6 +20,7 @@ import (
 )
 
 // @SDKDataSource("aws_codeartifact_authorization_token", name="Authoiration Token")
+// @NoMemoize
 func dataSourceAuthorizationToken() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceAuthorizationTokenRead,
@@ -25,9 +25,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
 		{
-			Factory:  dataSourceAuthorizationToken,
-			TypeName: "aws_codeartifact_authorization_token",
-			Name:     "Authoiration Token",
+			Factory:   dataSourceAuthorizationToken,
+			TypeName:  "aws_codeartifact_authorization_token",
+			Name:      "Authoiration Token",
+			NoMemoize: true,
 		},
 		{
 			Factory:  dataSourceRepositoryEndpoint,
@@ -0,0 +1,353 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Asset Type")
+func newResourceAssetType(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceAssetType{}, nil
+}
+
+const (
+	ResNameAssetType = "Asset Type"
+)
+
+type resourceAssetType struct {
+	framework.ResourceWithConfigure
+	framework.WithNoUpdate
+}
+
+func (r *resourceAssetType) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_datazone_asset_type"
+}
+
+func (r *resourceAssetType) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"origin_domain_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"origin_project_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"owning_project_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"revision": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_at": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"updated_by": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"forms_input": schema.SetNestedBlock{
+				CustomType: fwtypes.NewSetNestedObjectTypeOf[formEntryInputModel](ctx),
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						"map_block_key": schema.StringAttribute{
+							Required: true,
+						},
+						"required": schema.BoolAttribute{
+							Optional: true,
+							Computed: true,
+							Default:  booldefault.StaticBool(false),
+						},
+						"type_identifier": schema.StringAttribute{
+							Required: true,
+						},
+						"type_revision": schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceAssetType) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan assetTypeResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.CreateAssetTypeInput{}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, plan, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.CreateAssetType(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameAssetType, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	plan.setID()
+
+	output, err := findAssetTypeByName(ctx, conn, plan.DomainIdentifier.ValueString(), plan.Name.ValueString())
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameAssetType, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.flatten(ctx, output)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceAssetType) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state assetTypeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if err := state.InitFromID(); err != nil {
+		resp.Diagnostics.AddError("parsing resource ID", err.Error())
+		return
+	}
+
+	out, err := findAssetTypeByName(ctx, conn, state.DomainIdentifier.ValueString(), state.Name.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionSetting, ResNameAssetType, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.flatten(ctx, out)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceAssetType) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state assetTypeResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.DeleteAssetTypeInput{
+		DomainIdentifier: state.DomainIdentifier.ValueStringPointer(),
+		Identifier:       state.Name.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteAssetType(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameAssetType, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceAssetType) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root(names.AttrID), req, resp)
+}
+
+func findAssetTypeByName(ctx context.Context, conn *datazone.Client, domainID, name string) (*datazone.GetAssetTypeOutput, error) {
+	in := &datazone.GetAssetTypeInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(name),
+	}
+
+	out, err := conn.GetAssetType(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type assetTypeResourceModel struct {
+	CreatedAt               timetypes.RFC3339                                   `tfsdk:"created_at"`
+	CreatedBy               types.String                                        `tfsdk:"created_by"`
+	Description             types.String                                        `tfsdk:"description"`
+	DomainIdentifier        types.String                                        `tfsdk:"domain_identifier"`
+	FormsInput              fwtypes.SetNestedObjectValueOf[formEntryInputModel] `tfsdk:"forms_input"`
+	ID                      types.String                                        `tfsdk:"id"`
+	Name                    types.String                                        `tfsdk:"name"`
+	OriginDomainID          types.String                                        `tfsdk:"origin_domain_id"`
+	OriginProjectID         types.String                                        `tfsdk:"origin_project_id"`
+	OwningProjectIdentifier types.String                                        `tfsdk:"owning_project_identifier"`
+	Revision                types.String                                        `tfsdk:"revision"`
+	UpdatedAt               timetypes.RFC3339                                   `tfsdk:"updated_at"`
+	UpdatedBy               types.String                                        `tfsdk:"updated_by"`
+}
+
+const (
+	assetTypeResourceIDPartCount = 2
+)
+
+func (m *assetTypeResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(m.ID.ValueString(), assetTypeResourceIDPartCount, false)
+	if err != nil {
+		return err
+	}
+
+	m.DomainIdentifier = types.StringValue(parts[0])
+	m.Name = types.StringValue(parts[1])
+
+	return nil
+}
+
+func (m *assetTypeResourceModel) setID() {
+	m.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{m.DomainIdentifier.ValueString(), m.Name.ValueString()}, assetTypeResourceIDPartCount, false)))
+}
+
+func (m *assetTypeResourceModel) flatten(ctx context.Context, apiObject *datazone.GetAssetTypeOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, apiObject, m)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	m.OwningProjectIdentifier = fwflex.StringToFramework(ctx, apiObject.OwningProjectId)
+
+	// The API returns the form type name rather than the identifier supplied on creation.
+	forms := make([]*formEntryInputModel, 0, len(apiObject.FormsOutput))
+	for k, v := range apiObject.FormsOutput {
+		forms = append(forms, &formEntryInputModel{
+			MapBlockKey:    types.StringValue(k),
+			Required:       fwflex.BoolToFramework(ctx, v.Required),
+			TypeIdentifier: fwflex.StringToFramework(ctx, v.TypeName),
+			TypeRevision:   fwflex.StringToFramework(ctx, v.TypeRevision),
+		})
+	}
+	m.FormsInput = fwtypes.NewSetNestedObjectValueOfSliceMust(ctx, forms)
+
+	return diags
+}
+
+type formEntryInputModel struct {
+	MapBlockKey    types.String `tfsdk:"map_block_key"`
+	Required       types.Bool   `tfsdk:"required"`
+	TypeIdentifier types.String `tfsdk:"type_identifier"`
+	TypeRevision   types.String `tfsdk:"type_revision"`
+}
@@ -0,0 +1,487 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Environment")
+func newResourceEnvironment(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &resourceEnvironment{}
+
+	r.SetDefaultCreateTimeout(20 * time.Minute)
+	r.SetDefaultUpdateTimeout(20 * time.Minute)
+	r.SetDefaultDeleteTimeout(20 * time.Minute)
+
+	return r, nil
+}
+
+const (
+	ResNameEnvironment = "Environment"
+)
+
+type resourceEnvironment struct {
+	framework.ResourceWithConfigure
+	framework.WithTimeouts
+}
+
+func (r *resourceEnvironment) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_datazone_environment"
+}
+
+func (r *resourceEnvironment) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"aws_account_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"aws_account_region": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"blueprint_identifier": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"created_by": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrDescription: schema.StringAttribute{
+				Optional: true,
+			},
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment_profile_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"glossary_terms": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+			},
+			"project_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"provider_environment": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"provisioned_resources": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[resourceModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[resourceModel](ctx),
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.EnvironmentStatus](),
+				Computed:   true,
+			},
+		},
+		Blocks: map[string]schema.Block{
+			names.AttrTimeouts: timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+			"user_parameters": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[environmentParameterModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrName: schema.StringAttribute{
+							Required: true,
+						},
+						names.AttrValue: schema.StringAttribute{
+							Required: true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceEnvironment) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan environmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.CreateEnvironmentInput{}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, plan, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := conn.CreateEnvironment(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameEnvironment, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	createTimeout := r.CreateTimeout(ctx, plan.Timeouts)
+	output, err := waitEnvironmentCreated(ctx, conn, aws.ToString(out.DomainId), aws.ToString(out.Id), createTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionWaitingForCreation, ResNameEnvironment, plan.Name.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(plan.flatten(ctx, output)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceEnvironment) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state environmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findEnvironmentByID(ctx, conn, state.DomainIdentifier.ValueString(), state.ID.ValueString())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionSetting, ResNameEnvironment, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.flatten(ctx, out)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	state.EnvironmentProfileIdentifier = fwflex.StringToFramework(ctx, out.EnvironmentProfileId)
+	state.ProjectIdentifier = fwflex.StringToFramework(ctx, out.ProjectId)
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceEnvironment) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan, state environmentResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Description.Equal(state.Description) ||
+		!plan.GlossaryTerms.Equal(state.GlossaryTerms) ||
+		!plan.Name.Equal(state.Name) {
+		in := &datazone.UpdateEnvironmentInput{
+			Identifier: plan.ID.ValueStringPointer(),
+		}
+		resp.Diagnostics.Append(fwflex.Expand(ctx, plan, in)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		_, err := conn.UpdateEnvironment(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionUpdating, ResNameEnvironment, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		updateTimeout := r.UpdateTimeout(ctx, plan.Timeouts)
+		output, err := waitEnvironmentUpdated(ctx, conn, plan.DomainIdentifier.ValueString(), plan.ID.ValueString(), updateTimeout)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionWaitingForUpdate, ResNameEnvironment, plan.ID.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(plan.flatten(ctx, output)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	} else {
+		plan.Status = state.Status
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *resourceEnvironment) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state environmentResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.DeleteEnvironmentInput{
+		DomainIdentifier: state.DomainIdentifier.ValueStringPointer(),
+		Identifier:       state.ID.ValueStringPointer(),
+	}
+
+	_, err := conn.DeleteEnvironment(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return
+		}
+
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionDeleting, ResNameEnvironment, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	deleteTimeout := r.DeleteTimeout(ctx, state.Timeouts)
+	_, err = waitEnvironmentDeleted(ctx, conn, state.DomainIdentifier.ValueString(), state.ID.ValueString(), deleteTimeout)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionWaitingForDeletion, ResNameEnvironment, state.ID.String(), err),
+			err.Error(),
+		)
+		return
+	}
+}
+
+func (r *resourceEnvironment) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	domainID, id, err := parseDomainScopedID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Resource Import Invalid ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_identifier"), domainID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root(names.AttrID), id)...)
+}
+
+func waitEnvironmentCreated(ctx context.Context, conn *datazone.Client, domainID, id string, timeout time.Duration) (*datazone.GetEnvironmentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.EnvironmentStatusCreating),
+		Target:                    enum.Slice(awstypes.EnvironmentStatusActive),
+		Refresh:                   statusEnvironment(ctx, conn, domainID, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            40,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*datazone.GetEnvironmentOutput); ok {
+		tfresource.SetLastError(err, environmentDeploymentError(out.LastDeployment))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitEnvironmentUpdated(ctx context.Context, conn *datazone.Client, domainID, id string, timeout time.Duration) (*datazone.GetEnvironmentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:                   enum.Slice(awstypes.EnvironmentStatusUpdating),
+		Target:                    enum.Slice(awstypes.EnvironmentStatusActive),
+		Refresh:                   statusEnvironment(ctx, conn, domainID, id),
+		Timeout:                   timeout,
+		NotFoundChecks:            20,
+		ContinuousTargetOccurence: 2,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*datazone.GetEnvironmentOutput); ok {
+		tfresource.SetLastError(err, environmentDeploymentError(out.LastDeployment))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func waitEnvironmentDeleted(ctx context.Context, conn *datazone.Client, domainID, id string, timeout time.Duration) (*datazone.GetEnvironmentOutput, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending: enum.Slice(awstypes.EnvironmentStatusActive, awstypes.EnvironmentStatusDeleting),
+		Target:  []string{},
+		Refresh: statusEnvironment(ctx, conn, domainID, id),
+		Timeout: timeout,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+	if out, ok := outputRaw.(*datazone.GetEnvironmentOutput); ok {
+		tfresource.SetLastError(err, environmentDeploymentError(out.LastDeployment))
+
+		return out, err
+	}
+
+	return nil, err
+}
+
+func statusEnvironment(ctx context.Context, conn *datazone.Client, domainID, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		out, err := findEnvironmentByID(ctx, conn, domainID, id)
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return out, string(out.Status), nil
+	}
+}
+
+func findEnvironmentByID(ctx context.Context, conn *datazone.Client, domainID, id string) (*datazone.GetEnvironmentOutput, error) {
+	in := &datazone.GetEnvironmentInput{
+		DomainIdentifier: aws.String(domainID),
+		Identifier:       aws.String(id),
+	}
+
+	out, err := conn.GetEnvironment(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	if out.Status == awstypes.EnvironmentStatusDeleted {
+		return nil, &retry.NotFoundError{
+			Message:     string(out.Status),
+			LastRequest: in,
+		}
+	}
+
+	return out, nil
+}
+
+type environmentResourceModel struct {
+	AWSAccountID                 types.String                                               `tfsdk:"aws_account_id"`
+	AWSAccountRegion             types.String                                               `tfsdk:"aws_account_region"`
+	BlueprintIdentifier          types.String                                               `tfsdk:"blueprint_identifier"`
+	CreatedAt                    timetypes.RFC3339                                          `tfsdk:"created_at"`
+	CreatedBy                    types.String                                               `tfsdk:"created_by"`
+	Description                  types.String                                               `tfsdk:"description"`
+	DomainIdentifier             types.String                                               `tfsdk:"domain_identifier"`
+	EnvironmentProfileIdentifier types.String                                               `tfsdk:"environment_profile_identifier"`
+	GlossaryTerms                fwtypes.ListValueOf[types.String]                          `tfsdk:"glossary_terms"`
+	ID                           types.String                                               `tfsdk:"id"`
+	Name                         types.String                                               `tfsdk:"name"`
+	ProjectIdentifier            types.String                                               `tfsdk:"project_identifier"`
+	ProviderEnvironment          types.String                                               `tfsdk:"provider_environment"`
+	ProvisionedResources         fwtypes.ListNestedObjectValueOf[resourceModel]             `tfsdk:"provisioned_resources"`
+	Status                       fwtypes.StringEnum[awstypes.EnvironmentStatus]             `tfsdk:"status"`
+	Timeouts                     timeouts.Value                                             `tfsdk:"timeouts"`
+	UserParameters               fwtypes.ListNestedObjectValueOf[environmentParameterModel] `tfsdk:"user_parameters"`
+}
+
+func (m *environmentResourceModel) flatten(ctx context.Context, apiObject *datazone.GetEnvironmentOutput) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// The API returns parameter definitions rather than the values supplied.
+	userParameters := m.UserParameters
+	diags.Append(fwflex.Flatten(ctx, apiObject, m)...)
+	if diags.HasError() {
+		return diags
+	}
+	m.UserParameters = userParameters
+
+	m.BlueprintIdentifier = fwflex.StringToFramework(ctx, apiObject.EnvironmentBlueprintId)
+	m.ProviderEnvironment = fwflex.StringToFramework(ctx, apiObject.Provider)
+
+	return diags
+}
+
+type resourceModel struct {
+	Name     types.String `tfsdk:"name"`
+	Provider types.String `tfsdk:"provider"`
+	Type     types.String `tfsdk:"type"`
+	Value    types.String `tfsdk:"value"`
+}
@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataZoneEnvironmentProfile_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var v datazone.GetEnvironmentProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_environment_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentProfileConfig_basic(rName, "desc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttrPair(resourceName, "aws_account_id", "data.aws_caller_identity.current", names.AttrAccountID),
+					resource.TestCheckResourceAttrPair(resourceName, "aws_account_region", "data.aws_region.current", names.AttrName),
+					resource.TestCheckResourceAttrPair(resourceName, "environment_blueprint_identifier", "data.aws_datazone_environment_blueprint.test", names.AttrID),
+					resource.TestCheckResourceAttrPair(resourceName, "project_identifier", "aws_datazone_project.test", names.AttrID),
+					resource.TestCheckResourceAttr(resourceName, "user_parameters.#", "1"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateIdFunc:       testAccDomainScopedImportStateIDFunc(resourceName),
+				ImportStateVerifyIgnore: []string{"user_parameters"},
+			},
+			{
+				Config: testAccEnvironmentProfileConfig_basic(rName, "updated"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "updated"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataZoneEnvironmentProfile_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var v datazone.GetEnvironmentProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_environment_profile.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckEnvironmentProfileDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccEnvironmentProfileConfig_basic(rName, "desc"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckEnvironmentProfileExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfdatazone.ResourceEnvironmentProfile, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckEnvironmentProfileDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_datazone_environment_profile" {
+				continue
+			}
+
+			_, err := tfdatazone.FindEnvironmentProfileByID(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("DataZone Environment Profile %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckEnvironmentProfileExists(ctx context.Context, n string, v *datazone.GetEnvironmentProfileOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		output, err := tfdatazone.FindEnvironmentProfileByID(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccEnvironmentProfileConfig_base(rName string) string {
+	return acctest.ConfigCompose(testAccProjectConfig_base(rName), `
+data "aws_caller_identity" "current" {}
+
+data "aws_region" "current" {}
+
+data "aws_datazone_environment_blueprint" "test" {
+  domain_id = aws_datazone_domain.test.id
+  name      = "DefaultDataLake"
+  managed   = true
+}
+
+resource "aws_datazone_environment_blueprint_configuration" "test" {
+  domain_id                = aws_datazone_domain.test.id
+  environment_blueprint_id = data.aws_datazone_environment_blueprint.test.id
+  enabled_regions          = [data.aws_region.current.name]
+}
+`)
+}
+
+func testAccEnvironmentProfileConfig_basic(rName, description string) string {
+	return acctest.ConfigCompose(testAccEnvironmentProfileConfig_base(rName), fmt.Sprintf(`
+resource "aws_datazone_environment_profile" "test" {
+  domain_identifier                = aws_datazone_domain.test.id
+  environment_blueprint_identifier = aws_datazone_environment_blueprint_configuration.test.environment_blueprint_id
+  project_identifier               = aws_datazone_project.test.id
+  name                             = %[1]q
+  description                      = %[2]q
+  aws_account_id                   = data.aws_caller_identity.current.account_id
+  aws_account_region               = data.aws_region.current.name
+
+  user_parameters {
+    name  = "consumerGlueDbName"
+    value = "value"
+  }
+}
+`, rName, description))
+}
@@ -4,8 +4,13 @@
 package datazone
 
 import (
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
 	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
 )
 
 const (
@@ -17,3 +22,17 @@ func isResourceMissing(err error) bool {
 	// AccessDeniedException: User is not permitted to perform operation: GetDomain
 	return errs.IsA[*awstypes.ResourceNotFoundException](err) || errs.IsAErrorMessageContains[*awstypes.AccessDeniedException](err, "is not permitted to perform")
 }
+
+func projectDeletionErrors(apiObjects []awstypes.ProjectDeletionError) error {
+	return errors.Join(tfslices.ApplyToAll(apiObjects, func(v awstypes.ProjectDeletionError) error {
+		return fmt.Errorf("%s: %s", aws.ToString(v.Code), aws.ToString(v.Message))
+	})...)
+}
+
+func environmentDeploymentError(apiObject *awstypes.Deployment) error {
+	if apiObject == nil || apiObject.FailureReason == nil {
+		return nil
+	}
+
+	return fmt.Errorf("%s: %s", aws.ToString(apiObject.FailureReason.Code), aws.ToString(apiObject.FailureReason.Message))
+}
@@ -0,0 +1,20 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+)
+
+const domainScopedIDPartCount = 2
+
+// parseDomainScopedID parses an import ID of the form "domain-id,resource-id".
+func parseDomainScopedID(id string) (string, string, error) {
+	parts, err := flex.ExpandResourceId(id, domainScopedIDPartCount, false)
+	if err != nil {
+		return "", "", err
+	}
+
+	return parts[0], parts[1], nil
+}
@@ -25,6 +25,10 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
 	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newResourceAssetType,
+			Name:    "Asset Type",
+		},
 		{
 			Factory: newResourceDomain,
 			Name:    "Domain",
@@ -32,10 +36,38 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 				IdentifierAttribute: names.AttrARN,
 			},
 		},
+		{
+			Factory: newResourceEnvironment,
+			Name:    "Environment",
+		},
 		{
 			Factory: newResourceEnvironmentBlueprintConfiguration,
 			Name:    "Environment Blueprint Configuration",
 		},
+		{
+			Factory: newResourceEnvironmentProfile,
+			Name:    "Environment Profile",
+		},
+		{
+			Factory: newResourceFormType,
+			Name:    "Form Type",
+		},
+		{
+			Factory: newResourceGlossary,
+			Name:    "Glossary",
+		},
+		{
+			Factory: newResourceGlossaryTerm,
+			Name:    "Glossary Term",
+		},
+		{
+			Factory: newResourceProject,
+			Name:    "Project",
+		},
+		{
+			Factory: newResourceUserProfile,
+			Name:    "User Profile",
+		},
 	}
 }
 
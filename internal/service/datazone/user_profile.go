@@ -0,0 +1,310 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/create"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="User Profile")
+func newResourceUserProfile(_ context.Context) (resource.ResourceWithConfigure, error) {
+	return &resourceUserProfile{}, nil
+}
+
+const (
+	ResNameUserProfile = "User Profile"
+)
+
+type resourceUserProfile struct {
+	framework.ResourceWithConfigure
+}
+
+func (r *resourceUserProfile) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = "aws_datazone_user_profile"
+}
+
+func (r *resourceUserProfile) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"details": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[userProfileDetailsModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[userProfileDetailsModel](ctx),
+				Computed:    true,
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"domain_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrStatus: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.UserProfileStatus](),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.UserProfileType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"user_identifier": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_type": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.UserType](),
+				Optional:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *resourceUserProfile) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan userProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	in := &datazone.CreateUserProfileInput{
+		ClientToken: aws.String(sdkid.UniqueId()),
+	}
+	resp.Diagnostics.Append(fwflex.Expand(ctx, plan, in)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := conn.CreateUserProfile(ctx, in)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameUserProfile, plan.UserIdentifier.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	status := out.Status
+	if !plan.Status.IsUnknown() && plan.Status.ValueEnum() != status {
+		in := &datazone.UpdateUserProfileInput{
+			DomainIdentifier: plan.DomainIdentifier.ValueStringPointer(),
+			Status:           plan.Status.ValueEnum(),
+			Type:             out.Type,
+			UserIdentifier:   plan.UserIdentifier.ValueStringPointer(),
+		}
+
+		output, err := conn.UpdateUserProfile(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionCreating, ResNameUserProfile, plan.UserIdentifier.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		status = output.Status
+	}
+
+	resp.Diagnostics.Append(plan.flatten(ctx, out.Id, status, out.Type, out.Details)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, plan)...)
+}
+
+func (r *resourceUserProfile) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var state userProfileResourceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	out, err := findUserProfileByID(ctx, conn, state.DomainIdentifier.ValueString(), state.UserIdentifier.ValueString(), state.Type.ValueEnum())
+	if tfresource.NotFound(err) {
+		resp.State.RemoveResource(ctx)
+		return
+	}
+	if err != nil {
+		resp.Diagnostics.AddError(
+			create.ProblemStandardMessage(names.DataZone, create.ErrActionSetting, ResNameUserProfile, state.UserIdentifier.String(), err),
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(state.flatten(ctx, out.Id, out.Status, out.Type, out.Details)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *resourceUserProfile) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	conn := r.Meta().DataZoneClient(ctx)
+
+	var plan, state userProfileResourceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !plan.Status.Equal(state.Status) {
+		in := &datazone.UpdateUserProfileInput{
+			DomainIdentifier: plan.DomainIdentifier.ValueStringPointer(),
+			Status:           plan.Status.ValueEnum(),
+			Type:             state.Type.ValueEnum(),
+			UserIdentifier:   plan.UserIdentifier.ValueStringPointer(),
+		}
+
+		out, err := conn.UpdateUserProfile(ctx, in)
+		if err != nil {
+			resp.Diagnostics.AddError(
+				create.ProblemStandardMessage(names.DataZone, create.ErrActionUpdating, ResNameUserProfile, plan.UserIdentifier.String(), err),
+				err.Error(),
+			)
+			return
+		}
+
+		resp.Diagnostics.Append(plan.flatten(ctx, out.Id, out.Status, out.Type, out.Details)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// Delete removes the user profile from state only, as DataZone has no API for deleting user profiles.
+func (r *resourceUserProfile) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+}
+
+func (r *resourceUserProfile) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	domainID, userID, err := parseDomainScopedID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError("Resource Import Invalid ID", err.Error())
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("domain_identifier"), domainID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("user_identifier"), userID)...)
+}
+
+func findUserProfileByID(ctx context.Context, conn *datazone.Client, domainID, userID string, profileType awstypes.UserProfileType) (*datazone.GetUserProfileOutput, error) {
+	in := &datazone.GetUserProfileInput{
+		DomainIdentifier: aws.String(domainID),
+		Type:             profileType,
+		UserIdentifier:   aws.String(userID),
+	}
+
+	out, err := conn.GetUserProfile(ctx, in)
+	if err != nil {
+		if isResourceMissing(err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: in,
+			}
+		}
+
+		return nil, err
+	}
+
+	if out == nil {
+		return nil, tfresource.NewEmptyResultError(in)
+	}
+
+	return out, nil
+}
+
+type userProfileResourceModel struct {
+	Details          fwtypes.ListNestedObjectValueOf[userProfileDetailsModel] `tfsdk:"details"`
+	DomainIdentifier types.String                                             `tfsdk:"domain_identifier"`
+	ID               types.String                                             `tfsdk:"id"`
+	Status           fwtypes.StringEnum[awstypes.UserProfileStatus]           `tfsdk:"status"`
+	Type             fwtypes.StringEnum[awstypes.UserProfileType]             `tfsdk:"type"`
+	UserIdentifier   types.String                                             `tfsdk:"user_identifier"`
+	UserType         fwtypes.StringEnum[awstypes.UserType]                    `tfsdk:"user_type"`
+}
+
+func (m *userProfileResourceModel) flatten(ctx context.Context, id *string, status awstypes.UserProfileStatus, profileType awstypes.UserProfileType, details awstypes.UserProfileDetails) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	m.ID = fwflex.StringToFramework(ctx, id)
+	m.Status = fwtypes.StringEnumValue(status)
+	m.Type = fwtypes.StringEnumValue(profileType)
+
+	var detailsModel userProfileDetailsModel
+	switch v := details.(type) {
+	case *awstypes.UserProfileDetailsMemberIam:
+		var iam iamUserProfileDetailsModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &iam)...)
+		detailsModel.IAM = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &iam)
+		detailsModel.SSO = fwtypes.NewListNestedObjectValueOfNull[ssoUserProfileDetailsModel](ctx)
+	case *awstypes.UserProfileDetailsMemberSso:
+		var sso ssoUserProfileDetailsModel
+		diags.Append(fwflex.Flatten(ctx, v.Value, &sso)...)
+		detailsModel.IAM = fwtypes.NewListNestedObjectValueOfNull[iamUserProfileDetailsModel](ctx)
+		detailsModel.SSO = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &sso)
+	default:
+		m.Details = fwtypes.NewListNestedObjectValueOfNull[userProfileDetailsModel](ctx)
+		return diags
+	}
+	m.Details = fwtypes.NewListNestedObjectValueOfPtrMust(ctx, &detailsModel)
+
+	return diags
+}
+
+type userProfileDetailsModel struct {
+	IAM fwtypes.ListNestedObjectValueOf[iamUserProfileDetailsModel] `tfsdk:"iam"`
+	SSO fwtypes.ListNestedObjectValueOf[ssoUserProfileDetailsModel] `tfsdk:"sso"`
+}
+
+type iamUserProfileDetailsModel struct {
+	ARN types.String `tfsdk:"arn"`
+}
+
+type ssoUserProfileDetailsModel struct {
+	FirstName types.String `tfsdk:"first_name"`
+	LastName  types.String `tfsdk:"last_name"`
+	Username  types.String `tfsdk:"username"`
+}
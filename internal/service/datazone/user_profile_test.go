@@ -0,0 +1,116 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package datazone_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/datazone"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/datazone/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfdatazone "github.com/hashicorp/terraform-provider-aws/internal/service/datazone"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccDataZoneUserProfile_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+
+	var v datazone.GetUserProfileOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_datazone_user_profile.test"
+
+	// User profiles cannot be deleted and are removed along with their domain.
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.DataZoneServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckDomainDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccUserProfileConfig_basic(rName, "ASSIGNED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "user_identifier", "aws_iam_user.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "user_type", "IAM_USER"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "ASSIGNED"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, "IAM"),
+					resource.TestCheckResourceAttr(resourceName, "details.#", "1"),
+					resource.TestCheckResourceAttrPair(resourceName, "details.0.iam.0.arn", "aws_iam_user.test", names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrID),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    testAccUserProfileImportStateIDFunc(resourceName),
+				ImportStateVerifyIdentifierAttribute: "user_identifier",
+				ImportStateVerifyIgnore:              []string{"user_type"},
+			},
+			{
+				Config: testAccUserProfileConfig_basic(rName, "NOT_ASSIGNED"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckUserProfileExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, names.AttrStatus, "NOT_ASSIGNED"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckUserProfileExists(ctx context.Context, n string, v *datazone.GetUserProfileOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).DataZoneClient(ctx)
+
+		output, err := tfdatazone.FindUserProfileByID(ctx, conn, rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["user_identifier"], awstypes.UserProfileType(rs.Primary.Attributes[names.AttrType]))
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccUserProfileImportStateIDFunc(n string) resource.ImportStateIdFunc {
+	return func(s *terraform.State) (string, error) {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return "", fmt.Errorf("Not found: %s", n)
+		}
+
+		return fmt.Sprintf("%s,%s", rs.Primary.Attributes["domain_identifier"], rs.Primary.Attributes["user_identifier"]), nil
+	}
+}
+
+func testAccUserProfileConfig_basic(rName, status string) string {
+	return acctest.ConfigCompose(testAccDomainConfig_basic(rName), fmt.Sprintf(`
+resource "aws_iam_user" "test" {
+  name = %[1]q
+}
+
+resource "aws_datazone_user_profile" "test" {
+  domain_identifier = aws_datazone_domain.test.id
+  user_identifier   = aws_iam_user.test.arn
+  user_type         = "IAM_USER"
+  status            = %[2]q
+}
+`, rName, status))
+}
@@ -64,6 +64,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			ARN: &types.ServicePackageResourceARN{
+				Template:   "arn:{partition}:dynamodb:{region}:{account_id}:table/{name}",
+				IDTemplate: "{name}",
+			},
 		},
 		{
 			Factory:  resourceTableExport,
@@ -46,6 +46,7 @@ const (
 
 // @SDKResource("aws_dynamodb_table", name="Table")
 // @Tags(identifierAttribute="arn")
+// @ARNImport(template="arn:{partition}:dynamodb:{region}:{account_id}:table/{name}", id="{name}")
 func resourceTable() *schema.Resource {
 	//lintignore:R011
 	return &schema.Resource{
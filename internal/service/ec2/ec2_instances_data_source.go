@@ -15,6 +15,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
 	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/query"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -59,6 +60,7 @@ func DataSourceInstances() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"query": query.Schema(),
 		},
 	}
 }
@@ -99,6 +101,12 @@ func dataSourceInstancesRead(ctx context.Context, d *schema.ResourceData, meta i
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Instances: %s", err)
 	}
 
+	output, err = query.Filter(d.Get("query").(string), output)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Instances: %s", err)
+	}
+
 	var instanceIDs, privateIPs, publicIPs, ipv6Addresses []string
 
 	for _, v := range output {
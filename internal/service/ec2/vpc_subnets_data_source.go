@@ -13,6 +13,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/query"
 	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -33,6 +34,7 @@ func DataSourceSubnets() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"query":        query.Schema(),
 			names.AttrTags: tftags.TagsSchemaComputed(),
 		},
 	}
@@ -65,6 +67,12 @@ func dataSourceSubnetsRead(ctx context.Context, d *schema.ResourceData, meta int
 		return sdkdiag.AppendErrorf(diags, "reading EC2 Subnets: %s", err)
 	}
 
+	output, err = query.Filter(d.Get("query").(string), output)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading EC2 Subnets: %s", err)
+	}
+
 	var subnetIDs []string
 
 	for _, v := range output {
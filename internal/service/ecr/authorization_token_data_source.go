@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKDataSource("aws_ecr_authorization_token", name="Authorization Token")
+// @NoMemoize
 func dataSourceAuthorizationToken() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceAuthorizationTokenRead,
@@ -34,9 +34,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
 		{
-			Factory:  dataSourceAuthorizationToken,
-			TypeName: "aws_ecr_authorization_token",
-			Name:     "Authorization Token",
+			Factory:   dataSourceAuthorizationToken,
+			TypeName:  "aws_ecr_authorization_token",
+			Name:      "Authorization Token",
+			NoMemoize: true,
 		},
 		{
 			Factory:  dataSourceImage,
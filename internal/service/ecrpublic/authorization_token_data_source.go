@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKDataSource("aws_ecrpublic_authorization_token")
+// @NoMemoize
 func DataSourceAuthorizationToken() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceAuthorizationTokenRead,
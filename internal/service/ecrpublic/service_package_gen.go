@@ -25,8 +25,9 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
 		{
-			Factory:  DataSourceAuthorizationToken,
-			TypeName: "aws_ecrpublic_authorization_token",
+			Factory:   DataSourceAuthorizationToken,
+			TypeName:  "aws_ecrpublic_authorization_token",
+			NoMemoize: true,
 		},
 	}
 }
@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKDataSource("aws_eks_cluster_auth")
+// @NoMemoize
 func dataSourceClusterAuth() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceClusterAuthRead,
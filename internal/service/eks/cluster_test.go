@@ -740,6 +740,108 @@ func TestAccEKSCluster_Network_ipFamily(t *testing.T) {
 	})
 }
 
+func TestAccEKSCluster_ComputeConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster1, cluster2 types.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config:      testAccClusterConfig_computeConfigInconsistent(rName),
+				ExpectError: regexache.MustCompile(`must all be set to the same value`),
+			},
+			{
+				Config: testAccClusterConfig_computeConfig(rName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster1),
+					resource.TestCheckResourceAttr(resourceName, "bootstrap_self_managed_addons", "false"),
+					resource.TestCheckResourceAttr(resourceName, "compute_config.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "compute_config.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "compute_config.0.node_pools.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemAttr(resourceName, "compute_config.0.node_pools.*", "general-purpose"),
+					resource.TestCheckResourceAttrPair(resourceName, "compute_config.0.node_role_arn", "aws_iam_role.node", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, "kubernetes_network_config.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "kubernetes_network_config.0.elastic_load_balancing.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "kubernetes_network_config.0.elastic_load_balancing.0.enabled", "true"),
+					resource.TestCheckResourceAttr(resourceName, "storage_config.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "storage_config.0.block_storage.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "storage_config.0.block_storage.0.enabled", "true"),
+				),
+			},
+			{
+				ResourceName:            resourceName,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"bootstrap_self_managed_addons"},
+			},
+			{
+				Config: testAccClusterConfig_computeConfig(rName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster2),
+					testAccCheckClusterNotRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "compute_config.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "compute_config.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "kubernetes_network_config.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "kubernetes_network_config.0.elastic_load_balancing.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "kubernetes_network_config.0.elastic_load_balancing.0.enabled", "false"),
+					resource.TestCheckResourceAttr(resourceName, "storage_config.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "storage_config.0.block_storage.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "storage_config.0.block_storage.0.enabled", "false"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSCluster_RemoteNetworkConfig(t *testing.T) {
+	ctx := acctest.Context(t)
+	var cluster1, cluster2 types.Cluster
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_eks_cluster.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckClusterDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterConfig_remoteNetworkConfig(rName, "10.90.0.0/22", "10.80.0.0/22"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster1),
+					resource.TestCheckResourceAttr(resourceName, "remote_network_config.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "remote_network_config.0.remote_node_networks.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "remote_network_config.0.remote_node_networks.0.cidrs.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemAttr(resourceName, "remote_network_config.0.remote_node_networks.0.cidrs.*", "10.90.0.0/22"),
+					resource.TestCheckResourceAttr(resourceName, "remote_network_config.0.remote_pod_networks.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "remote_network_config.0.remote_pod_networks.0.cidrs.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemAttr(resourceName, "remote_network_config.0.remote_pod_networks.0.cidrs.*", "10.80.0.0/22"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccClusterConfig_remoteNetworkConfig(rName, "10.91.0.0/22", "10.80.0.0/22"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckClusterExists(ctx, resourceName, &cluster2),
+					testAccCheckClusterRecreated(&cluster1, &cluster2),
+					resource.TestCheckResourceAttr(resourceName, "remote_network_config.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemAttr(resourceName, "remote_network_config.0.remote_node_networks.0.cidrs.*", "10.91.0.0/22"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccEKSCluster_Outpost_create(t *testing.T) {
 	ctx := acctest.Context(t)
 	var cluster types.Cluster
@@ -1270,6 +1372,184 @@ resource "aws_eks_cluster" "test" {
 `, rName, ipFamily))
 }
 
+func testAccClusterConfig_computeConfigBase(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_base(rName), fmt.Sprintf(`
+resource "aws_iam_role" "auto_mode" {
+  name = "%[1]s-auto"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "eks.${data.aws_partition.current.dns_suffix}"
+      },
+      "Action": [
+        "sts:AssumeRole",
+        "sts:TagSession"
+      ]
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy_attachment" "auto_mode" {
+  for_each = toset([
+    "AmazonEKSClusterPolicy",
+    "AmazonEKSComputePolicy",
+    "AmazonEKSBlockStoragePolicy",
+    "AmazonEKSLoadBalancingPolicy",
+    "AmazonEKSNetworkingPolicy",
+  ])
+
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/${each.value}"
+  role       = aws_iam_role.auto_mode.name
+}
+
+resource "aws_iam_role" "node" {
+  name = "%[1]s-node"
+
+  assume_role_policy = <<POLICY
+{
+  "Version": "2012-10-17",
+  "Statement": [
+    {
+      "Effect": "Allow",
+      "Principal": {
+        "Service": "ec2.${data.aws_partition.current.dns_suffix}"
+      },
+      "Action": "sts:AssumeRole"
+    }
+  ]
+}
+POLICY
+}
+
+resource "aws_iam_role_policy_attachment" "node" {
+  for_each = toset([
+    "AmazonEKSWorkerNodeMinimalPolicy",
+    "AmazonEC2ContainerRegistryPullOnly",
+  ])
+
+  policy_arn = "arn:${data.aws_partition.current.partition}:iam::aws:policy/${each.value}"
+  role       = aws_iam_role.node.name
+}
+`, rName))
+}
+
+func testAccClusterConfig_computeConfig(rName string, enabled bool) string {
+	return acctest.ConfigCompose(testAccClusterConfig_computeConfigBase(rName), fmt.Sprintf(`
+resource "aws_eks_cluster" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.auto_mode.arn
+
+  bootstrap_self_managed_addons = false
+
+  access_config {
+    authentication_mode = "API"
+  }
+
+  compute_config {
+    enabled       = %[2]t
+    node_pools    = %[2]t ? ["general-purpose"] : null
+    node_role_arn = %[2]t ? aws_iam_role.node.arn : null
+  }
+
+  kubernetes_network_config {
+    elastic_load_balancing {
+      enabled = %[2]t
+    }
+  }
+
+  storage_config {
+    block_storage {
+      enabled = %[2]t
+    }
+  }
+
+  vpc_config {
+    endpoint_private_access = true
+    endpoint_public_access  = true
+    subnet_ids              = aws_subnet.test[*].id
+  }
+
+  depends_on = [
+    aws_iam_role_policy_attachment.auto_mode,
+    aws_iam_role_policy_attachment.node,
+  ]
+}
+`, rName, enabled))
+}
+
+func testAccClusterConfig_computeConfigInconsistent(rName string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_computeConfigBase(rName), fmt.Sprintf(`
+resource "aws_eks_cluster" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.auto_mode.arn
+
+  bootstrap_self_managed_addons = false
+
+  access_config {
+    authentication_mode = "API"
+  }
+
+  compute_config {
+    enabled = true
+  }
+
+  kubernetes_network_config {
+    elastic_load_balancing {
+      enabled = true
+    }
+  }
+
+  storage_config {
+    block_storage {
+      enabled = false
+    }
+  }
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.auto_mode]
+}
+`, rName))
+}
+
+func testAccClusterConfig_remoteNetworkConfig(rName, remoteNodeCIDR, remotePodCIDR string) string {
+	return acctest.ConfigCompose(testAccClusterConfig_base(rName), fmt.Sprintf(`
+resource "aws_eks_cluster" "test" {
+  name     = %[1]q
+  role_arn = aws_iam_role.test.arn
+
+  access_config {
+    authentication_mode = "API"
+  }
+
+  remote_network_config {
+    remote_node_networks {
+      cidrs = [%[2]q]
+    }
+
+    remote_pod_networks {
+      cidrs = [%[3]q]
+    }
+  }
+
+  vpc_config {
+    subnet_ids = aws_subnet.test[*].id
+  }
+
+  depends_on = [aws_iam_role_policy_attachment.test-AmazonEKSClusterPolicy]
+}
+`, rName, remoteNodeCIDR, remotePodCIDR))
+}
+
 func testAccClusterConfig_outpost(rName string) string {
 	return acctest.ConfigCompose(testAccClusterConfig_base(rName), fmt.Sprintf(`
 data "aws_iam_role" "test" {
@@ -0,0 +1,139 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/service/eks"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/eks/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Cluster Versions")
+func newClusterVersionsDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &clusterVersionsDataSource{}
+
+	return d, nil
+}
+
+type clusterVersionsDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*clusterVersionsDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_eks_cluster_versions"
+}
+
+func (d *clusterVersionsDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"cluster_type": schema.StringAttribute{
+				Optional: true,
+			},
+			"cluster_versions": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[clusterVersionModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[clusterVersionModel](ctx),
+				Computed:    true,
+			},
+			"cluster_versions_only": schema.ListAttribute{
+				CustomType:  fwtypes.ListOfStringType,
+				ElementType: types.StringType,
+				Optional:    true,
+			},
+			"default_only": schema.BoolAttribute{
+				Optional: true,
+			},
+			"include_all": schema.BoolAttribute{
+				Optional: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			"version_status": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ClusterVersionStatus](),
+				Optional:   true,
+			},
+		},
+	}
+}
+
+func (d *clusterVersionsDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data clusterVersionsDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().EKSClient(ctx)
+
+	input := &eks.DescribeClusterVersionsInput{
+		ClusterType:     fwflex.StringFromFramework(ctx, data.ClusterType),
+		ClusterVersions: fwflex.ExpandFrameworkStringValueList(ctx, data.ClusterVersionsOnly),
+		DefaultOnly:     fwflex.BoolFromFramework(ctx, data.DefaultOnly),
+		IncludeAll:      fwflex.BoolFromFramework(ctx, data.IncludeAll),
+		Status:          data.VersionStatus.ValueEnum(),
+	}
+
+	output, err := findClusterVersions(ctx, conn, input)
+
+	if err != nil {
+		response.Diagnostics.AddError("reading EKS Cluster Versions", err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, &eks.DescribeClusterVersionsOutput{ClusterVersions: output}, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.ID = fwflex.StringValueToFramework(ctx, d.Meta().Region)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func findClusterVersions(ctx context.Context, conn *eks.Client, input *eks.DescribeClusterVersionsInput) ([]awstypes.ClusterVersionInformation, error) {
+	var output []awstypes.ClusterVersionInformation
+
+	pages := eks.NewDescribeClusterVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ClusterVersions...)
+	}
+
+	return output, nil
+}
+
+type clusterVersionsDataSourceModel struct {
+	ClusterType         types.String                                         `tfsdk:"cluster_type"`
+	ClusterVersions     fwtypes.ListNestedObjectValueOf[clusterVersionModel] `tfsdk:"cluster_versions"`
+	ClusterVersionsOnly fwtypes.ListValueOf[types.String]                    `tfsdk:"cluster_versions_only"`
+	DefaultOnly         types.Bool                                           `tfsdk:"default_only"`
+	ID                  types.String                                         `tfsdk:"id"`
+	IncludeAll          types.Bool                                           `tfsdk:"include_all"`
+	VersionStatus       fwtypes.StringEnum[awstypes.ClusterVersionStatus]    `tfsdk:"version_status"`
+}
+
+type clusterVersionModel struct {
+	ClusterType              types.String                                      `tfsdk:"cluster_type"`
+	ClusterVersion           types.String                                      `tfsdk:"cluster_version"`
+	DefaultPlatformVersion   types.String                                      `tfsdk:"default_platform_version"`
+	DefaultVersion           types.Bool                                        `tfsdk:"default_version"`
+	EndOfExtendedSupportDate timetypes.RFC3339                                 `tfsdk:"end_of_extended_support_date"`
+	EndOfStandardSupportDate timetypes.RFC3339                                 `tfsdk:"end_of_standard_support_date"`
+	KubernetesPatchVersion   types.String                                      `tfsdk:"kubernetes_patch_version"`
+	ReleaseDate              timetypes.RFC3339                                 `tfsdk:"release_date"`
+	Status                   fwtypes.StringEnum[awstypes.ClusterVersionStatus] `tfsdk:"version_status"`
+}
@@ -0,0 +1,98 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package eks_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccEKSClusterVersionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_eks_cluster_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterVersionsDataSourceConfig_basic(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					acctest.CheckResourceAttrGreaterThanValue(dataSourceName, "cluster_versions.#", 0),
+					resource.TestCheckResourceAttrSet(dataSourceName, "cluster_versions.0.cluster_type"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "cluster_versions.0.cluster_version"),
+					resource.TestCheckResourceAttrSet(dataSourceName, "cluster_versions.0.version_status"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSClusterVersionsDataSource_clusterVersionsOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_eks_cluster_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterVersionsDataSourceConfig_clusterVersionsOnly(clusterVersionUpgradeUpdated),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cluster_versions.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(dataSourceName, "cluster_versions.0.cluster_version", clusterVersionUpgradeUpdated),
+				),
+			},
+		},
+	})
+}
+
+func TestAccEKSClusterVersionsDataSource_defaultOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	dataSourceName := "data.aws_eks_cluster_versions.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.EKSServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccClusterVersionsDataSourceConfig_defaultOnly(),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "cluster_versions.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(dataSourceName, "cluster_versions.0.default_version", "true"),
+				),
+			},
+		},
+	})
+}
+
+func testAccClusterVersionsDataSourceConfig_basic() string {
+	return `
+data "aws_eks_cluster_versions" "test" {}
+`
+}
+
+func testAccClusterVersionsDataSourceConfig_clusterVersionsOnly(version string) string {
+	return fmt.Sprintf(`
+data "aws_eks_cluster_versions" "test" {
+  cluster_versions_only = [%[1]q]
+  include_all           = true
+}
+`, version)
+}
+
+func testAccClusterVersionsDataSourceConfig_defaultOnly() string {
+	return `
+data "aws_eks_cluster_versions" "test" {
+  default_only = true
+}
+`
+}
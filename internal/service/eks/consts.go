@@ -25,6 +25,18 @@ const (
 	propagationTimeout = 2 * time.Minute
 )
 
+const (
+	nodePoolGeneralPurpose = "general-purpose"
+	nodePoolSystem         = "system"
+)
+
+func nodePool_Values() []string {
+	return []string{
+		nodePoolGeneralPurpose,
+		nodePoolSystem,
+	}
+}
+
 const (
 	accessEntryTypeEC2Linux     = "EC2_LINUX"
 	accessEntryTypeEC2Windows   = "EC2_WINDOWS"
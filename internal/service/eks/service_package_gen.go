@@ -15,7 +15,12 @@ import (
 type servicePackage struct{}
 
 func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.ServicePackageFrameworkDataSource {
-	return []*types.ServicePackageFrameworkDataSource{}
+	return []*types.ServicePackageFrameworkDataSource{
+		{
+			Factory: newClusterVersionsDataSource,
+			Name:    "Cluster Versions",
+		},
+	}
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
@@ -50,8 +55,9 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			TypeName: "aws_eks_cluster",
 		},
 		{
-			Factory:  dataSourceClusterAuth,
-			TypeName: "aws_eks_cluster_auth",
+			Factory:   dataSourceClusterAuth,
+			TypeName:  "aws_eks_cluster_auth",
+			NoMemoize: true,
 		},
 		{
 			Factory:  dataSourceClusters,
@@ -9,6 +9,7 @@ var (
 	ResourceGlobalReplicationGroup = resourceGlobalReplicationGroup
 	ResourceParameterGroup         = resourceParameterGroup
 	ResourceReplicationGroup       = resourceReplicationGroup
+	ResourceReservedCacheNode      = resourceReservedCacheNode
 	ResourceServerlessCache        = newServerlessCacheResource
 	ResourceSubnetGroup            = resourceSubnetGroup
 	ResourceUser                   = resourceUser
@@ -21,6 +22,7 @@ var (
 	FindCacheSubnetGroupByName           = findCacheSubnetGroupByName
 	FindGlobalReplicationGroupByID       = findGlobalReplicationGroupByID
 	FindReplicationGroupByID             = findReplicationGroupByID
+	FindReservedCacheNodeByID            = findReservedCacheNodeByID
 	FindServerlessCacheByID              = findServerlessCacheByID
 	FindUserByID                         = findUserByID
 	FindUserGroupByID                    = findUserGroupByID
@@ -0,0 +1,302 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticache
+
+import (
+	"context"
+	"log"
+	"time"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/internal/verify"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKResource("aws_elasticache_reserved_cache_node", name="Reserved Cache Node")
+// @Tags(identifierAttribute="arn")
+func resourceReservedCacheNode() *schema.Resource {
+	return &schema.Resource{
+		CreateWithoutTimeout: resourceReservedCacheNodeCreate,
+		ReadWithoutTimeout:   resourceReservedCacheNodeRead,
+		UpdateWithoutTimeout: resourceReservedCacheNodeUpdate,
+		DeleteWithoutTimeout: schema.NoopContext,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(30 * time.Minute),
+			Update: schema.DefaultTimeout(10 * time.Minute),
+			Delete: schema.DefaultTimeout(1 * time.Minute),
+		},
+
+		Schema: map[string]*schema.Schema{
+			names.AttrARN: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"cache_node_count": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ForceNew:     true,
+				Default:      1,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+			"cache_node_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrDuration: {
+				Type:     schema.TypeInt,
+				Computed: true,
+			},
+			"fixed_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"offering_id": {
+				Type:     schema.TypeString,
+				Required: true,
+				ForceNew: true,
+			},
+			"offering_type": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"product_description": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"recurring_charges": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"recurring_charge_amount": {
+							Type:     schema.TypeFloat,
+							Computed: true,
+						},
+						"recurring_charge_frequency": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"reservation_id": {
+				Type:     schema.TypeString,
+				Optional: true,
+				Computed: true,
+				ForceNew: true,
+			},
+			names.AttrStartTime: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrState: {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			names.AttrTags:    tftags.TagsSchema(),
+			names.AttrTagsAll: tftags.TagsSchemaComputed(),
+			"usage_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+		},
+
+		CustomizeDiff: verify.SetTagsDiff,
+	}
+}
+
+func resourceReservedCacheNodeCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElastiCacheClient(ctx)
+
+	offeringID := d.Get("offering_id").(string)
+	input := &elasticache.PurchaseReservedCacheNodesOfferingInput{
+		CacheNodeCount:               aws.Int32(int32(d.Get("cache_node_count").(int))),
+		ReservedCacheNodesOfferingId: aws.String(offeringID),
+		Tags:                         getTagsInV2(ctx),
+	}
+
+	if v, ok := d.GetOk("reservation_id"); ok {
+		input.ReservedCacheNodeId = aws.String(v.(string))
+	}
+
+	output, err := conn.PurchaseReservedCacheNodesOffering(ctx, input)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "purchasing ElastiCache Reserved Cache Node (%s): %s", offeringID, err)
+	}
+
+	d.SetId(aws.ToString(output.ReservedCacheNode.ReservedCacheNodeId))
+
+	if _, err := waitReservedCacheNodeCreated(ctx, conn, d.Id(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "waiting for ElastiCache Reserved Cache Node (%s) create: %s", d.Id(), err)
+	}
+
+	return append(diags, resourceReservedCacheNodeRead(ctx, d, meta)...)
+}
+
+func resourceReservedCacheNodeRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElastiCacheClient(ctx)
+
+	reservation, err := findReservedCacheNodeByID(ctx, conn, d.Id())
+
+	if !d.IsNewResource() && tfresource.NotFound(err) {
+		log.Printf("[WARN] ElastiCache Reserved Cache Node (%s) not found, removing from state", d.Id())
+		d.SetId("")
+		return diags
+	}
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading ElastiCache Reserved Cache Node (%s): %s", d.Id(), err)
+	}
+
+	d.Set(names.AttrARN, reservation.ReservationARN)
+	d.Set("cache_node_count", reservation.CacheNodeCount)
+	d.Set("cache_node_type", reservation.CacheNodeType)
+	d.Set(names.AttrDuration, reservation.Duration)
+	d.Set("fixed_price", reservation.FixedPrice)
+	d.Set("offering_id", reservation.ReservedCacheNodesOfferingId)
+	d.Set("offering_type", reservation.OfferingType)
+	d.Set("product_description", reservation.ProductDescription)
+	if err := d.Set("recurring_charges", flattenRecurringCharges(reservation.RecurringCharges)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting recurring_charges: %s", err)
+	}
+	d.Set("reservation_id", reservation.ReservedCacheNodeId)
+	if reservation.StartTime != nil {
+		d.Set(names.AttrStartTime, aws.ToTime(reservation.StartTime).Format(time.RFC3339))
+	} else {
+		d.Set(names.AttrStartTime, nil)
+	}
+	d.Set(names.AttrState, reservation.State)
+	d.Set("usage_price", reservation.UsagePrice)
+
+	return diags
+}
+
+func resourceReservedCacheNodeUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	// Tags only.
+
+	return append(diags, resourceReservedCacheNodeRead(ctx, d, meta)...)
+}
+
+func findReservedCacheNodeByID(ctx context.Context, conn *elasticache.Client, id string) (*awstypes.ReservedCacheNode, error) {
+	input := &elasticache.DescribeReservedCacheNodesInput{
+		ReservedCacheNodeId: aws.String(id),
+	}
+
+	return findReservedCacheNode(ctx, conn, input)
+}
+
+func findReservedCacheNode(ctx context.Context, conn *elasticache.Client, input *elasticache.DescribeReservedCacheNodesInput) (*awstypes.ReservedCacheNode, error) {
+	output, err := findReservedCacheNodes(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findReservedCacheNodes(ctx context.Context, conn *elasticache.Client, input *elasticache.DescribeReservedCacheNodesInput) ([]awstypes.ReservedCacheNode, error) {
+	var output []awstypes.ReservedCacheNode
+
+	pages := elasticache.NewDescribeReservedCacheNodesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ReservedCacheNodeNotFoundFault](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ReservedCacheNodes...)
+	}
+
+	return output, nil
+}
+
+func statusReservedCacheNode(ctx context.Context, conn *elasticache.Client, id string) retry.StateRefreshFunc {
+	return func() (interface{}, string, error) {
+		output, err := findReservedCacheNodeByID(ctx, conn, id)
+
+		if tfresource.NotFound(err) {
+			return nil, "", nil
+		}
+
+		if err != nil {
+			return nil, "", err
+		}
+
+		return output, aws.ToString(output.State), nil
+	}
+}
+
+const (
+	reservedCacheNodeStateActive         = "active"
+	reservedCacheNodeStatePaymentPending = "payment-pending"
+)
+
+func waitReservedCacheNodeCreated(ctx context.Context, conn *elasticache.Client, id string, timeout time.Duration) (*awstypes.ReservedCacheNode, error) {
+	stateConf := &retry.StateChangeConf{
+		Pending:        []string{reservedCacheNodeStatePaymentPending},
+		Target:         []string{reservedCacheNodeStateActive},
+		Refresh:        statusReservedCacheNode(ctx, conn, id),
+		NotFoundChecks: 5,
+		Timeout:        timeout,
+		MinTimeout:     10 * time.Second,
+		Delay:          30 * time.Second,
+	}
+
+	outputRaw, err := stateConf.WaitForStateContext(ctx)
+
+	if output, ok := outputRaw.(*awstypes.ReservedCacheNode); ok {
+		return output, err
+	}
+
+	return nil, err
+}
+
+func flattenRecurringCharges(apiObjects []awstypes.RecurringCharge) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			"recurring_charge_amount":    aws.ToFloat64(apiObject.RecurringChargeAmount),
+			"recurring_charge_frequency": aws.ToString(apiObject.RecurringChargeFrequency),
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
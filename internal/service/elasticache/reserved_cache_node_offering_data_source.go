@@ -0,0 +1,127 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticache
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/elasticache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_elasticache_reserved_cache_node_offering", name="Reserved Cache Node Offering")
+func dataSourceReservedCacheNodeOffering() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceReservedCacheNodeOfferingRead,
+
+		Schema: map[string]*schema.Schema{
+			"cache_node_type": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			names.AttrDuration: {
+				Type:         schema.TypeInt,
+				Required:     true,
+				ValidateFunc: validation.IntInSlice([]int{31536000, 94608000}),
+			},
+			"fixed_price": {
+				Type:     schema.TypeFloat,
+				Computed: true,
+			},
+			"offering_id": {
+				Type:     schema.TypeString,
+				Computed: true,
+			},
+			"offering_type": {
+				Type:     schema.TypeString,
+				Required: true,
+				ValidateFunc: validation.StringInSlice([]string{
+					"Light Utilization",
+					"Medium Utilization",
+					"Heavy Utilization",
+					"Partial Upfront",
+					"All Upfront",
+					"No Upfront",
+				}, false),
+			},
+			"product_description": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+		},
+	}
+}
+
+func dataSourceReservedCacheNodeOfferingRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).ElastiCacheClient(ctx)
+
+	input := &elasticache.DescribeReservedCacheNodesOfferingsInput{
+		CacheNodeType:      aws.String(d.Get("cache_node_type").(string)),
+		Duration:           aws.String(strconv.Itoa(d.Get(names.AttrDuration).(int))),
+		OfferingType:       aws.String(d.Get("offering_type").(string)),
+		ProductDescription: aws.String(d.Get("product_description").(string)),
+	}
+
+	offering, err := findReservedCacheNodeOffering(ctx, conn, input)
+
+	if err != nil {
+		return sdkdiag.AppendFromErr(diags, tfresource.SingularDataSourceFindError("ElastiCache Reserved Cache Node Offering", err))
+	}
+
+	d.SetId(aws.ToString(offering.ReservedCacheNodesOfferingId))
+	d.Set("cache_node_type", offering.CacheNodeType)
+	d.Set(names.AttrDuration, offering.Duration)
+	d.Set("fixed_price", offering.FixedPrice)
+	d.Set("offering_id", offering.ReservedCacheNodesOfferingId)
+	d.Set("offering_type", offering.OfferingType)
+	d.Set("product_description", offering.ProductDescription)
+
+	return diags
+}
+
+func findReservedCacheNodeOffering(ctx context.Context, conn *elasticache.Client, input *elasticache.DescribeReservedCacheNodesOfferingsInput) (*awstypes.ReservedCacheNodesOffering, error) {
+	output, err := findReservedCacheNodeOfferings(ctx, conn, input)
+
+	if err != nil {
+		return nil, err
+	}
+
+	return tfresource.AssertSingleValueResult(output)
+}
+
+func findReservedCacheNodeOfferings(ctx context.Context, conn *elasticache.Client, input *elasticache.DescribeReservedCacheNodesOfferingsInput) ([]awstypes.ReservedCacheNodesOffering, error) {
+	var output []awstypes.ReservedCacheNodesOffering
+
+	pages := elasticache.NewDescribeReservedCacheNodesOfferingsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.ReservedCacheNodesOfferingNotFoundFault](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.ReservedCacheNodesOfferings...)
+	}
+
+	return output, nil
+}
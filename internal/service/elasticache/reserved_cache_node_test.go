@@ -0,0 +1,99 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package elasticache_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/elasticache/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfelasticache "github.com/hashicorp/terraform-provider-aws/internal/service/elasticache"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccElastiCacheReservedCacheNode_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	key := "RUN_ELASTICACHE_RESERVED_CACHE_NODE_TESTS"
+	if os.Getenv(key) != "true" {
+		t.Skipf("Environment variable %s is not set to true", key)
+	}
+
+	var reservation awstypes.ReservedCacheNode
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_elasticache_reserved_cache_node.test"
+	dataSourceName := "data.aws_elasticache_reserved_cache_node_offering.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.ElastiCacheServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             nil,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccReservedCacheNodeConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckReservedCacheNodeExists(ctx, resourceName, &reservation),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "elasticache", regexache.MustCompile(`reserved-instance:.+`)),
+					resource.TestCheckResourceAttr(resourceName, "cache_node_count", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(dataSourceName, "cache_node_type", resourceName, "cache_node_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, names.AttrDuration, resourceName, names.AttrDuration),
+					resource.TestCheckResourceAttrPair(dataSourceName, "fixed_price", resourceName, "fixed_price"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "offering_id", resourceName, "offering_id"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "offering_type", resourceName, "offering_type"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "product_description", resourceName, "product_description"),
+					resource.TestCheckResourceAttrSet(resourceName, "recurring_charges.#"),
+					resource.TestCheckResourceAttr(resourceName, "reservation_id", rName),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrStartTime),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrState),
+					resource.TestCheckResourceAttrSet(resourceName, "usage_price"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckReservedCacheNodeExists(ctx context.Context, n string, v *awstypes.ReservedCacheNode) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).ElastiCacheClient(ctx)
+
+		output, err := tfelasticache.FindReservedCacheNodeByID(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccReservedCacheNodeConfig_basic(rName string) string {
+	return fmt.Sprintf(`
+data "aws_elasticache_reserved_cache_node_offering" "test" {
+  cache_node_type     = "cache.t4g.small"
+  duration            = 31536000
+  offering_type       = "No Upfront"
+  product_description = "redis"
+}
+
+resource "aws_elasticache_reserved_cache_node" "test" {
+  offering_id    = data.aws_elasticache_reserved_cache_node_offering.test.offering_id
+  reservation_id = %[1]q
+}
+`, rName)
+}
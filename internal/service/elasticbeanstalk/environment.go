@@ -133,7 +133,7 @@ func ResourceEnvironment() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
-			"endpoint_url": {
+			names.AttrEndpointURL: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
@@ -359,7 +359,7 @@ func resourceEnvironmentRead(ctx context.Context, d *schema.ResourceData, meta i
 		d.Set("cname_prefix", "")
 	}
 	d.Set(names.AttrDescription, env.Description)
-	d.Set("endpoint_url", env.EndpointURL)
+	d.Set(names.AttrEndpointURL, env.EndpointURL)
 	if err := d.Set("instances", flattenInstances(resources.EnvironmentResources.Instances)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting instances: %s", err)
 	}
@@ -78,5 +78,10 @@ func dataSourceHostedZoneIDRead(ctx context.Context, d *schema.ResourceData, met
 		return diags
 	}
 
+	if zoneId, ok := names.HostedZoneIDForCustomRegion("elb", region); ok {
+		d.SetId(zoneId)
+		return diags
+	}
+
 	return sdkdiag.AppendErrorf(diags, "Unknown region (%q)", region)
 }
@@ -129,12 +129,16 @@ func dataSourceHostedZoneIDRead(ctx context.Context, d *schema.ResourceData, met
 	if lbType == elbv2.LoadBalancerTypeEnumApplication {
 		if zoneId, ok := HostedZoneIdPerRegionALBMap[region]; ok {
 			d.SetId(zoneId)
+		} else if zoneId, ok := names.HostedZoneIDForCustomRegion("alb", region); ok {
+			d.SetId(zoneId)
 		} else {
 			return sdkdiag.AppendErrorf(diags, "unsupported AWS Region: %s", region)
 		}
 	} else if lbType == elbv2.LoadBalancerTypeEnumNetwork {
 		if zoneId, ok := HostedZoneIdPerRegionNLBMap[region]; ok {
 			d.SetId(zoneId)
+		} else if zoneId, ok := names.HostedZoneIDForCustomRegion("nlb", region); ok {
+			d.SetId(zoneId)
 		} else {
 			return sdkdiag.AppendErrorf(diags, "unsupported AWS Region: %s", region)
 		}
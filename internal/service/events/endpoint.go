@@ -48,7 +48,7 @@ func resourceEndpoint() *schema.Resource {
 				Optional:     true,
 				ValidateFunc: validation.StringLenBetween(0, 512),
 			},
-			"endpoint_url": {
+			names.AttrEndpointURL: {
 				Type:     schema.TypeString,
 				Computed: true,
 			},
@@ -205,7 +205,7 @@ func resourceEndpointRead(ctx context.Context, d *schema.ResourceData, meta inte
 
 	d.Set(names.AttrARN, output.Arn)
 	d.Set(names.AttrDescription, output.Description)
-	d.Set("endpoint_url", output.EndpointUrl)
+	d.Set(names.AttrEndpointURL, output.EndpointUrl)
 	if err := d.Set("event_bus", flattenEndpointEventBuses(output.EventBuses)); err != nil {
 		return sdkdiag.AppendErrorf(diags, "setting event_bus: %s", err)
 	}
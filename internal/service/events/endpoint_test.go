@@ -39,7 +39,7 @@ func TestAccEventsEndpoint_basic(t *testing.T) {
 					testAccCheckEndpointExists(ctx, resourceName, &v),
 					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "events", fmt.Sprintf("endpoint/%s", rName)),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
-					resource.TestCheckResourceAttrSet(resourceName, "endpoint_url"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrEndpointURL),
 					resource.TestCheckResourceAttr(resourceName, "event_bus.#", "2"),
 					resource.TestCheckResourceAttrPair(resourceName, "event_bus.0.event_bus_arn", "aws_cloudwatch_event_bus.primary", names.AttrARN),
 					resource.TestCheckResourceAttrPair(resourceName, "event_bus.1.event_bus_arn", "aws_cloudwatch_event_bus.secondary", names.AttrARN),
@@ -108,7 +108,7 @@ func TestAccEventsEndpoint_roleARN(t *testing.T) {
 					testAccCheckEndpointExists(ctx, resourceName, &v),
 					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "events", fmt.Sprintf("endpoint/%s", rName)),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
-					resource.TestCheckResourceAttrSet(resourceName, "endpoint_url"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrEndpointURL),
 					resource.TestCheckResourceAttr(resourceName, "event_bus.#", "2"),
 					resource.TestCheckResourceAttrPair(resourceName, "event_bus.0.event_bus_arn", "aws_cloudwatch_event_bus.primary", names.AttrARN),
 					resource.TestCheckResourceAttrPair(resourceName, "event_bus.1.event_bus_arn", "aws_cloudwatch_event_bus.secondary", names.AttrARN),
@@ -152,7 +152,7 @@ func TestAccEventsEndpoint_description(t *testing.T) {
 					testAccCheckEndpointExists(ctx, resourceName, &v),
 					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "events", fmt.Sprintf("endpoint/%s", rName)),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 1"),
-					resource.TestCheckResourceAttrSet(resourceName, "endpoint_url"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrEndpointURL),
 					resource.TestCheckResourceAttr(resourceName, "event_bus.#", "2"),
 					resource.TestCheckResourceAttrPair(resourceName, "event_bus.0.event_bus_arn", "aws_cloudwatch_event_bus.primary", names.AttrARN),
 					resource.TestCheckResourceAttrPair(resourceName, "event_bus.1.event_bus_arn", "aws_cloudwatch_event_bus.secondary", names.AttrARN),
@@ -179,7 +179,7 @@ func TestAccEventsEndpoint_description(t *testing.T) {
 					testAccCheckEndpointExists(ctx, resourceName, &v),
 					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "events", fmt.Sprintf("endpoint/%s", rName)),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, "description 2"),
-					resource.TestCheckResourceAttrSet(resourceName, "endpoint_url"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrEndpointURL),
 					resource.TestCheckResourceAttr(resourceName, "event_bus.#", "2"),
 					resource.TestCheckResourceAttrPair(resourceName, "event_bus.0.event_bus_arn", "aws_cloudwatch_event_bus.primary", names.AttrARN),
 					resource.TestCheckResourceAttrPair(resourceName, "event_bus.1.event_bus_arn", "aws_cloudwatch_event_bus.secondary", names.AttrARN),
@@ -218,7 +218,7 @@ func TestAccEventsEndpoint_updateRoutingConfig(t *testing.T) {
 					testAccCheckEndpointExists(ctx, resourceName, &v),
 					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "events", fmt.Sprintf("endpoint/%s", rName)),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
-					resource.TestCheckResourceAttrSet(resourceName, "endpoint_url"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrEndpointURL),
 					resource.TestCheckResourceAttr(resourceName, "event_bus.#", "2"),
 					resource.TestCheckResourceAttrPair(resourceName, "event_bus.0.event_bus_arn", "aws_cloudwatch_event_bus.primary", names.AttrARN),
 					resource.TestCheckResourceAttrPair(resourceName, "event_bus.1.event_bus_arn", "aws_cloudwatch_event_bus.secondary", names.AttrARN),
@@ -240,7 +240,7 @@ func TestAccEventsEndpoint_updateRoutingConfig(t *testing.T) {
 					testAccCheckEndpointExists(ctx, resourceName, &v),
 					acctest.CheckResourceAttrRegionalARN(resourceName, names.AttrARN, "events", fmt.Sprintf("endpoint/%s", rName)),
 					resource.TestCheckResourceAttr(resourceName, names.AttrDescription, ""),
-					resource.TestCheckResourceAttrSet(resourceName, "endpoint_url"),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrEndpointURL),
 					resource.TestCheckResourceAttr(resourceName, "event_bus.#", "2"),
 					resource.TestCheckResourceAttrPair(resourceName, "event_bus.0.event_bus_arn", "aws_cloudwatch_event_bus.primary", names.AttrARN),
 					resource.TestCheckResourceAttrPair(resourceName, "event_bus.1.event_bus_arn", "aws_cloudwatch_event_bus.secondary", names.AttrARN),
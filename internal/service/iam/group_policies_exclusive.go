@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/exclusive"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Group Policies Exclusive")
+func newGroupPoliciesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &groupPoliciesExclusiveResource{}, nil
+}
+
+type groupPoliciesExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpDelete
+}
+
+func (r *groupPoliciesExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iam_group_policies_exclusive"
+}
+
+func (r *groupPoliciesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrGroupName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"policy_names": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *groupPoliciesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data groupPoliciesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	groupName := data.GroupName.ValueString()
+	if err := groupPoliciesCollection(conn, groupName).Sync(ctx, fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyNames)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IAM Group Policies Exclusive (%s)", groupName), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *groupPoliciesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data groupPoliciesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	groupName := data.GroupName.ValueString()
+	collection := groupPoliciesCollection(conn, groupName)
+	drift, have, err := collection.Drift(ctx, fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyNames))
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IAM Group Policies Exclusive (%s)", groupName), err.Error())
+
+		return
+	}
+
+	// The policy names are null after import.
+	if !data.PolicyNames.IsNull() && !drift.IsEmpty() {
+		response.Diagnostics.Append(exclusive.NewDriftWarningDiagnostic(collection.Description, drift))
+	}
+
+	data.PolicyNames = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, have)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *groupPoliciesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new groupPoliciesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	groupName := new.GroupName.ValueString()
+	if err := groupPoliciesCollection(conn, groupName).Sync(ctx, fwflex.ExpandFrameworkStringValueSet(ctx, new.PolicyNames)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating IAM Group Policies Exclusive (%s)", groupName), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// groupPoliciesCollection returns the exclusively managed inline policies of the specified IAM group.
+// Inline policies are created by aws_iam_group_policy, so only unconfigured policies are deleted.
+func groupPoliciesCollection(conn *iam.Client, groupName string) *exclusive.Collection[string] {
+	return &exclusive.Collection[string]{
+		Description: fmt.Sprintf("IAM Group (%s) inline policies", groupName),
+		List: func(ctx context.Context) ([]string, error) {
+			return findGroupPolicyNames(ctx, conn, groupName)
+		},
+		Remove: func(ctx context.Context, policyNames []string) error {
+			return deleteGroupInlinePolicies(ctx, conn, groupName, policyNames)
+		},
+		Retryable: retryableConcurrentModification,
+		Timeout:   propagationTimeout,
+	}
+}
+
+func findGroupPolicyNames(ctx context.Context, conn *iam.Client, groupName string) ([]string, error) {
+	input := &iam.ListGroupPoliciesInput{
+		GroupName: aws.String(groupName),
+	}
+	var output []string
+
+	pages := iam.NewListGroupPoliciesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.NoSuchEntityException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.PolicyNames {
+			if v != "" {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func deleteGroupInlinePolicies(ctx context.Context, conn *iam.Client, groupName string, policyNames []string) error {
+	var errsList []error
+
+	for _, policyName := range policyNames {
+		input := &iam.DeleteGroupPolicyInput{
+			PolicyName: aws.String(policyName),
+			GroupName:  aws.String(groupName),
+		}
+
+		_, err := conn.DeleteGroupPolicy(ctx, input)
+
+		if errs.IsA[*awstypes.NoSuchEntityException](err) {
+			continue
+		}
+
+		if err != nil {
+			errsList = append(errsList, fmt.Errorf("deleting IAM Group (%s) policy (%s): %w", groupName, policyName, err))
+		}
+	}
+
+	return errors.Join(errsList...)
+}
+
+type groupPoliciesExclusiveResourceModel struct {
+	GroupName   types.String `tfsdk:"group_name"`
+	ID          types.String `tfsdk:"id"`
+	PolicyNames types.Set    `tfsdk:"policy_names"`
+}
+
+func (model *groupPoliciesExclusiveResourceModel) InitFromID() error {
+	model.GroupName = model.ID
+
+	return nil
+}
+
+func (model *groupPoliciesExclusiveResourceModel) setID() {
+	model.ID = model.GroupName
+}
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/exclusive"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Group Policy Attachments Exclusive")
+func newGroupPolicyAttachmentsExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &groupPolicyAttachmentsExclusiveResource{}, nil
+}
+
+type groupPolicyAttachmentsExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpDelete
+}
+
+func (r *groupPolicyAttachmentsExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iam_group_policy_attachments_exclusive"
+}
+
+func (r *groupPolicyAttachmentsExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrGroupName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"policy_arns": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *groupPolicyAttachmentsExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data groupPolicyAttachmentsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	groupName := data.GroupName.ValueString()
+	if err := groupPolicyAttachmentsCollection(conn, groupName).Sync(ctx, fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyARNs)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IAM Group Policy Attachments Exclusive (%s)", groupName), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *groupPolicyAttachmentsExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data groupPolicyAttachmentsExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	groupName := data.GroupName.ValueString()
+	collection := groupPolicyAttachmentsCollection(conn, groupName)
+	drift, have, err := collection.Drift(ctx, fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyARNs))
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IAM Group Policy Attachments Exclusive (%s)", groupName), err.Error())
+
+		return
+	}
+
+	// The policy ARNs are null after import.
+	if !data.PolicyARNs.IsNull() && !drift.IsEmpty() {
+		response.Diagnostics.Append(exclusive.NewDriftWarningDiagnostic(collection.Description, drift))
+	}
+
+	data.PolicyARNs = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, have)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *groupPolicyAttachmentsExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new groupPolicyAttachmentsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	groupName := new.GroupName.ValueString()
+	if err := groupPolicyAttachmentsCollection(conn, groupName).Sync(ctx, fwflex.ExpandFrameworkStringValueSet(ctx, new.PolicyARNs)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating IAM Group Policy Attachments Exclusive (%s)", groupName), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// groupPolicyAttachmentsCollection returns the exclusively managed policy attachments of the specified IAM group.
+func groupPolicyAttachmentsCollection(conn *iam.Client, groupName string) *exclusive.Collection[string] {
+	return &exclusive.Collection[string]{
+		Description: fmt.Sprintf("IAM Group (%s) policy attachments", groupName),
+		List: func(ctx context.Context) ([]string, error) {
+			output, err := findAttachedGroupPolicies(ctx, conn, &iam.ListAttachedGroupPoliciesInput{
+				GroupName: aws.String(groupName),
+			}, tfslices.PredicateTrue[awstypes.AttachedPolicy]())
+
+			if err != nil {
+				return nil, err
+			}
+
+			return tfslices.ApplyToAll(output, func(v awstypes.AttachedPolicy) string {
+				return aws.ToString(v.PolicyArn)
+			}), nil
+		},
+		Add: func(ctx context.Context, policyARNs []string) error {
+			return attachPoliciesToGroup(ctx, conn, groupName, policyARNs)
+		},
+		Remove: func(ctx context.Context, policyARNs []string) error {
+			return detachPoliciesFromGroup(ctx, conn, groupName, policyARNs)
+		},
+	}
+}
+
+func attachPoliciesToGroup(ctx context.Context, conn *iam.Client, groupName string, policyARNs []string) error {
+	var errs []error
+
+	for _, policyARN := range policyARNs {
+		if err := attachPolicyToGroup(ctx, conn, groupName, policyARN); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func detachPoliciesFromGroup(ctx context.Context, conn *iam.Client, groupName string, policyARNs []string) error {
+	var errs []error
+
+	for _, policyARN := range policyARNs {
+		if err := detachPolicyFromGroup(ctx, conn, groupName, policyARN); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+type groupPolicyAttachmentsExclusiveResourceModel struct {
+	GroupName  types.String `tfsdk:"group_name"`
+	ID         types.String `tfsdk:"id"`
+	PolicyARNs types.Set    `tfsdk:"policy_arns"`
+}
+
+func (model *groupPolicyAttachmentsExclusiveResourceModel) InitFromID() error {
+	model.GroupName = model.ID
+
+	return nil
+}
+
+func (model *groupPolicyAttachmentsExclusiveResourceModel) setID() {
+	model.ID = model.GroupName
+}
@@ -0,0 +1,181 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/exclusive"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Role Policies Exclusive")
+func newRolePoliciesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &rolePoliciesExclusiveResource{}, nil
+}
+
+type rolePoliciesExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpDelete
+}
+
+func (r *rolePoliciesExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iam_role_policies_exclusive"
+}
+
+func (r *rolePoliciesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"policy_names": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"role_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *rolePoliciesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data rolePoliciesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	roleName := data.RoleName.ValueString()
+	if err := rolePoliciesCollection(conn, roleName).Sync(ctx, fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyNames)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IAM Role Policies Exclusive (%s)", roleName), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *rolePoliciesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data rolePoliciesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	roleName := data.RoleName.ValueString()
+	collection := rolePoliciesCollection(conn, roleName)
+	drift, have, err := collection.Drift(ctx, fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyNames))
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IAM Role Policies Exclusive (%s)", roleName), err.Error())
+
+		return
+	}
+
+	// The policy names are null after import.
+	if !data.PolicyNames.IsNull() && !drift.IsEmpty() {
+		response.Diagnostics.Append(exclusive.NewDriftWarningDiagnostic(collection.Description, drift))
+	}
+
+	data.PolicyNames = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, have)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *rolePoliciesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new rolePoliciesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	roleName := new.RoleName.ValueString()
+	if err := rolePoliciesCollection(conn, roleName).Sync(ctx, fwflex.ExpandFrameworkStringValueSet(ctx, new.PolicyNames)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating IAM Role Policies Exclusive (%s)", roleName), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// rolePoliciesCollection returns the exclusively managed inline policies of the specified IAM role.
+// Inline policies are created by aws_iam_role_policy, so only unconfigured policies are deleted.
+func rolePoliciesCollection(conn *iam.Client, roleName string) *exclusive.Collection[string] {
+	return &exclusive.Collection[string]{
+		Description: fmt.Sprintf("IAM Role (%s) inline policies", roleName),
+		List: func(ctx context.Context) ([]string, error) {
+			return findRolePolicyNames(ctx, conn, roleName)
+		},
+		Remove: func(ctx context.Context, policyNames []string) error {
+			return deleteRoleInlinePolicies(ctx, conn, roleName, policyNames)
+		},
+		Retryable: retryableConcurrentModification,
+		Timeout:   propagationTimeout,
+	}
+}
+
+// retryableConcurrentModification retries IAM calls that fail because of concurrent modification of the same entity.
+func retryableConcurrentModification(err error) (bool, error) {
+	if errs.IsA[*awstypes.ConcurrentModificationException](err) {
+		return true, err
+	}
+
+	return false, err
+}
+
+type rolePoliciesExclusiveResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	PolicyNames types.Set    `tfsdk:"policy_names"`
+	RoleName    types.String `tfsdk:"role_name"`
+}
+
+func (model *rolePoliciesExclusiveResourceModel) InitFromID() error {
+	model.RoleName = model.ID
+
+	return nil
+}
+
+func (model *rolePoliciesExclusiveResourceModel) setID() {
+	model.ID = model.RoleName
+}
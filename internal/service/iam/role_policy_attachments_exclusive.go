@@ -0,0 +1,195 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/exclusive"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Role Policy Attachments Exclusive")
+func newRolePolicyAttachmentsExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &rolePolicyAttachmentsExclusiveResource{}, nil
+}
+
+type rolePolicyAttachmentsExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpDelete
+}
+
+func (r *rolePolicyAttachmentsExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iam_role_policy_attachments_exclusive"
+}
+
+func (r *rolePolicyAttachmentsExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"policy_arns": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			"role_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *rolePolicyAttachmentsExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data rolePolicyAttachmentsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	roleName := data.RoleName.ValueString()
+	if err := rolePolicyAttachmentsCollection(conn, roleName).Sync(ctx, fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyARNs)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IAM Role Policy Attachments Exclusive (%s)", roleName), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *rolePolicyAttachmentsExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data rolePolicyAttachmentsExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	roleName := data.RoleName.ValueString()
+	collection := rolePolicyAttachmentsCollection(conn, roleName)
+	drift, have, err := collection.Drift(ctx, fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyARNs))
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IAM Role Policy Attachments Exclusive (%s)", roleName), err.Error())
+
+		return
+	}
+
+	// The policy ARNs are null after import.
+	if !data.PolicyARNs.IsNull() && !drift.IsEmpty() {
+		response.Diagnostics.Append(exclusive.NewDriftWarningDiagnostic(collection.Description, drift))
+	}
+
+	data.PolicyARNs = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, have)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *rolePolicyAttachmentsExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new rolePolicyAttachmentsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	roleName := new.RoleName.ValueString()
+	if err := rolePolicyAttachmentsCollection(conn, roleName).Sync(ctx, fwflex.ExpandFrameworkStringValueSet(ctx, new.PolicyARNs)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating IAM Role Policy Attachments Exclusive (%s)", roleName), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// rolePolicyAttachmentsCollection returns the exclusively managed policy attachments of the specified IAM role.
+func rolePolicyAttachmentsCollection(conn *iam.Client, roleName string) *exclusive.Collection[string] {
+	return &exclusive.Collection[string]{
+		Description: fmt.Sprintf("IAM Role (%s) policy attachments", roleName),
+		List: func(ctx context.Context) ([]string, error) {
+			return findRoleAttachedPolicies(ctx, conn, roleName)
+		},
+		Add: func(ctx context.Context, policyARNs []string) error {
+			return attachPoliciesToRole(ctx, conn, roleName, policyARNs)
+		},
+		Remove: func(ctx context.Context, policyARNs []string) error {
+			return detachPoliciesFromRole(ctx, conn, roleName, policyARNs)
+		},
+	}
+}
+
+func attachPoliciesToRole(ctx context.Context, conn *iam.Client, roleName string, policyARNs []string) error {
+	var errs []error
+
+	for _, policyARN := range policyARNs {
+		if err := attachPolicyToRole(ctx, conn, roleName, policyARN); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func detachPoliciesFromRole(ctx context.Context, conn *iam.Client, roleName string, policyARNs []string) error {
+	var errs []error
+
+	for _, policyARN := range policyARNs {
+		if err := detachPolicyFromRole(ctx, conn, roleName, policyARN); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+type rolePolicyAttachmentsExclusiveResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	PolicyARNs types.Set    `tfsdk:"policy_arns"`
+	RoleName   types.String `tfsdk:"role_name"`
+}
+
+func (model *rolePolicyAttachmentsExclusiveResourceModel) InitFromID() error {
+	model.RoleName = model.ID
+
+	return nil
+}
+
+func (model *rolePolicyAttachmentsExclusiveResourceModel) setID() {
+	model.ID = model.RoleName
+}
@@ -16,6 +16,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/query"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
@@ -44,6 +45,7 @@ func dataSourceRoles() *schema.Resource {
 				Type:     schema.TypeString,
 				Optional: true,
 			},
+			"query": query.Schema(),
 		},
 	}
 }
@@ -80,6 +82,12 @@ func dataSourceRolesRead(ctx context.Context, d *schema.ResourceData, meta inter
 		}
 	}
 
+	results, err := query.Filter(d.Get("query").(string), results)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "reading IAM roles: %s", err)
+	}
+
 	d.SetId(meta.(*conns.AWSClient).Region)
 
 	var arns, nms []string
@@ -22,6 +22,7 @@ import (
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/sdkv2"
 	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
 	"github.com/hashicorp/terraform-provider-aws/names"
 )
@@ -31,6 +32,7 @@ func resourceUserLoginProfile() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceUserLoginProfileCreate,
 		ReadWithoutTimeout:   resourceUserLoginProfileRead,
+		UpdateWithoutTimeout: resourceUserLoginProfileUpdate,
 		DeleteWithoutTimeout: resourceUserLoginProfileDelete,
 
 		Importer: &schema.ResourceImporter{
@@ -48,9 +50,10 @@ func resourceUserLoginProfile() *schema.Resource {
 				ForceNew: true,
 			},
 			"pgp_key": {
-				Type:     schema.TypeString,
-				Optional: true,
-				ForceNew: true,
+				Type:          schema.TypeString,
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"password_wo"},
 			},
 			"password_reset_required": {
 				Type:     schema.TypeBool,
@@ -65,6 +68,19 @@ func resourceUserLoginProfile() *schema.Resource {
 				ForceNew:     true,
 				ValidateFunc: validation.IntBetween(5, 128),
 			},
+			"password_wo": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				Sensitive:        true,
+				DiffSuppressFunc: sdkv2.SuppressWriteOnly,
+				ConflictsWith:    []string{"pgp_key"},
+				RequiredWith:     []string{"password_wo_version"},
+			},
+			"password_wo_version": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				RequiredWith: []string{"password_wo"},
+			},
 
 			"key_fingerprint": {
 				Type:     schema.TypeString,
@@ -141,12 +157,20 @@ func resourceUserLoginProfileCreate(ctx context.Context, d *schema.ResourceData,
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
 	username := d.Get("user").(string)
 
-	passwordLength := d.Get("password_length").(int)
-	initialPassword, err := GeneratePassword(passwordLength)
+	passwordWO, err := sdkv2.GetWriteOnlyStringValue(d, "password_wo")
 	if err != nil {
 		return sdkdiag.AppendErrorf(diags, "creating IAM User Login Profile for %q: %s", username, err)
 	}
 
+	initialPassword := passwordWO
+	if initialPassword == "" {
+		passwordLength := d.Get("password_length").(int)
+		initialPassword, err = GeneratePassword(passwordLength)
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "creating IAM User Login Profile for %q: %s", username, err)
+		}
+	}
+
 	request := &iam.CreateLoginProfileInput{
 		UserName:              aws.String(username),
 		Password:              aws.String(initialPassword),
@@ -173,7 +197,7 @@ func resourceUserLoginProfileCreate(ctx context.Context, d *schema.ResourceData,
 
 		d.Set("key_fingerprint", fingerprint)
 		d.Set("encrypted_password", encrypted)
-	} else {
+	} else if passwordWO == "" {
 		d.Set(names.AttrPassword, initialPassword)
 	}
 
@@ -228,6 +252,31 @@ func resourceUserLoginProfileRead(ctx context.Context, d *schema.ResourceData, m
 	return diags
 }
 
+func resourceUserLoginProfileUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).IAMClient(ctx)
+
+	if d.HasChange("password_wo_version") {
+		passwordWO, err := sdkv2.GetWriteOnlyStringValue(d, "password_wo")
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IAM User Login Profile (%s): %s", d.Id(), err)
+		}
+
+		input := &iam.UpdateLoginProfileInput{
+			Password: aws.String(passwordWO),
+			UserName: aws.String(d.Id()),
+		}
+
+		_, err = conn.UpdateLoginProfile(ctx, input)
+
+		if err != nil {
+			return sdkdiag.AppendErrorf(diags, "updating IAM User Login Profile (%s): %s", d.Id(), err)
+		}
+	}
+
+	return append(diags, resourceUserLoginProfileRead(ctx, d, meta)...)
+}
+
 func resourceUserLoginProfileDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).IAMClient(ctx)
@@ -0,0 +1,229 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/exclusive"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="User Policies Exclusive")
+func newUserPoliciesExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &userPoliciesExclusiveResource{}, nil
+}
+
+type userPoliciesExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpDelete
+}
+
+func (r *userPoliciesExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iam_user_policies_exclusive"
+}
+
+func (r *userPoliciesExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"policy_names": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			names.AttrUserName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *userPoliciesExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data userPoliciesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	userName := data.UserName.ValueString()
+	if err := userPoliciesCollection(conn, userName).Sync(ctx, fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyNames)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IAM User Policies Exclusive (%s)", userName), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *userPoliciesExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data userPoliciesExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	userName := data.UserName.ValueString()
+	collection := userPoliciesCollection(conn, userName)
+	drift, have, err := collection.Drift(ctx, fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyNames))
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IAM User Policies Exclusive (%s)", userName), err.Error())
+
+		return
+	}
+
+	// The policy names are null after import.
+	if !data.PolicyNames.IsNull() && !drift.IsEmpty() {
+		response.Diagnostics.Append(exclusive.NewDriftWarningDiagnostic(collection.Description, drift))
+	}
+
+	data.PolicyNames = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, have)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *userPoliciesExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new userPoliciesExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	userName := new.UserName.ValueString()
+	if err := userPoliciesCollection(conn, userName).Sync(ctx, fwflex.ExpandFrameworkStringValueSet(ctx, new.PolicyNames)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating IAM User Policies Exclusive (%s)", userName), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// userPoliciesCollection returns the exclusively managed inline policies of the specified IAM user.
+// Inline policies are created by aws_iam_user_policy, so only unconfigured policies are deleted.
+func userPoliciesCollection(conn *iam.Client, userName string) *exclusive.Collection[string] {
+	return &exclusive.Collection[string]{
+		Description: fmt.Sprintf("IAM User (%s) inline policies", userName),
+		List: func(ctx context.Context) ([]string, error) {
+			return findUserPolicyNames(ctx, conn, userName)
+		},
+		Remove: func(ctx context.Context, policyNames []string) error {
+			return deleteUserInlinePolicies(ctx, conn, userName, policyNames)
+		},
+		Retryable: retryableConcurrentModification,
+		Timeout:   propagationTimeout,
+	}
+}
+
+func findUserPolicyNames(ctx context.Context, conn *iam.Client, userName string) ([]string, error) {
+	input := &iam.ListUserPoliciesInput{
+		UserName: aws.String(userName),
+	}
+	var output []string
+
+	pages := iam.NewListUserPoliciesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsA[*awstypes.NoSuchEntityException](err) {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.PolicyNames {
+			if v != "" {
+				output = append(output, v)
+			}
+		}
+	}
+
+	return output, nil
+}
+
+func deleteUserInlinePolicies(ctx context.Context, conn *iam.Client, userName string, policyNames []string) error {
+	var errsList []error
+
+	for _, policyName := range policyNames {
+		input := &iam.DeleteUserPolicyInput{
+			PolicyName: aws.String(policyName),
+			UserName:   aws.String(userName),
+		}
+
+		_, err := conn.DeleteUserPolicy(ctx, input)
+
+		if errs.IsA[*awstypes.NoSuchEntityException](err) {
+			continue
+		}
+
+		if err != nil {
+			errsList = append(errsList, fmt.Errorf("deleting IAM User (%s) policy (%s): %w", userName, policyName, err))
+		}
+	}
+
+	return errors.Join(errsList...)
+}
+
+type userPoliciesExclusiveResourceModel struct {
+	ID          types.String `tfsdk:"id"`
+	PolicyNames types.Set    `tfsdk:"policy_names"`
+	UserName    types.String `tfsdk:"user_name"`
+}
+
+func (model *userPoliciesExclusiveResourceModel) InitFromID() error {
+	model.UserName = model.ID
+
+	return nil
+}
+
+func (model *userPoliciesExclusiveResourceModel) setID() {
+	model.ID = model.UserName
+}
@@ -0,0 +1,208 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package iam
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/iam"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/iam/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/exclusive"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	tfslices "github.com/hashicorp/terraform-provider-aws/internal/slices"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="User Policy Attachments Exclusive")
+func newUserPolicyAttachmentsExclusiveResource(context.Context) (resource.ResourceWithConfigure, error) {
+	return &userPolicyAttachmentsExclusiveResource{}, nil
+}
+
+type userPolicyAttachmentsExclusiveResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+	framework.WithNoOpDelete
+}
+
+func (r *userPolicyAttachmentsExclusiveResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_iam_user_policy_attachments_exclusive"
+}
+
+func (r *userPolicyAttachmentsExclusiveResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrID: framework.IDAttribute(),
+			"policy_arns": schema.SetAttribute{
+				ElementType: types.StringType,
+				Required:    true,
+			},
+			names.AttrUserName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+		},
+	}
+}
+
+func (r *userPolicyAttachmentsExclusiveResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data userPolicyAttachmentsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	userName := data.UserName.ValueString()
+	if err := userPolicyAttachmentsCollection(conn, userName).Sync(ctx, fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyARNs)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating IAM User Policy Attachments Exclusive (%s)", userName), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.setID()
+
+	response.Diagnostics.Append(response.State.Set(ctx, data)...)
+}
+
+func (r *userPolicyAttachmentsExclusiveResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data userPolicyAttachmentsExclusiveResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	userName := data.UserName.ValueString()
+	collection := userPolicyAttachmentsCollection(conn, userName)
+	drift, have, err := collection.Drift(ctx, fwflex.ExpandFrameworkStringValueSet(ctx, data.PolicyARNs))
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading IAM User Policy Attachments Exclusive (%s)", userName), err.Error())
+
+		return
+	}
+
+	// The policy ARNs are null after import.
+	if !data.PolicyARNs.IsNull() && !drift.IsEmpty() {
+		response.Diagnostics.Append(exclusive.NewDriftWarningDiagnostic(collection.Description, drift))
+	}
+
+	data.PolicyARNs = fwflex.FlattenFrameworkStringValueSetLegacy(ctx, have)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *userPolicyAttachmentsExclusiveResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new userPolicyAttachmentsExclusiveResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().IAMClient(ctx)
+
+	userName := new.UserName.ValueString()
+	if err := userPolicyAttachmentsCollection(conn, userName).Sync(ctx, fwflex.ExpandFrameworkStringValueSet(ctx, new.PolicyARNs)); err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating IAM User Policy Attachments Exclusive (%s)", userName), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+// userPolicyAttachmentsCollection returns the exclusively managed policy attachments of the specified IAM user.
+func userPolicyAttachmentsCollection(conn *iam.Client, userName string) *exclusive.Collection[string] {
+	return &exclusive.Collection[string]{
+		Description: fmt.Sprintf("IAM User (%s) policy attachments", userName),
+		List: func(ctx context.Context) ([]string, error) {
+			output, err := findAttachedUserPolicies(ctx, conn, &iam.ListAttachedUserPoliciesInput{
+				UserName: aws.String(userName),
+			}, tfslices.PredicateTrue[awstypes.AttachedPolicy]())
+
+			if err != nil {
+				return nil, err
+			}
+
+			return tfslices.ApplyToAll(output, func(v awstypes.AttachedPolicy) string {
+				return aws.ToString(v.PolicyArn)
+			}), nil
+		},
+		Add: func(ctx context.Context, policyARNs []string) error {
+			return attachPoliciesToUser(ctx, conn, userName, policyARNs)
+		},
+		Remove: func(ctx context.Context, policyARNs []string) error {
+			return detachPoliciesFromUser(ctx, conn, userName, policyARNs)
+		},
+	}
+}
+
+func attachPoliciesToUser(ctx context.Context, conn *iam.Client, userName string, policyARNs []string) error {
+	var errs []error
+
+	for _, policyARN := range policyARNs {
+		if err := attachPolicyToUser(ctx, conn, userName, policyARN); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+func detachPoliciesFromUser(ctx context.Context, conn *iam.Client, userName string, policyARNs []string) error {
+	var errs []error
+
+	for _, policyARN := range policyARNs {
+		if err := detachPolicyFromUser(ctx, conn, userName, policyARN); err != nil {
+			errs = append(errs, err)
+		}
+	}
+
+	return errors.Join(errs...)
+}
+
+type userPolicyAttachmentsExclusiveResourceModel struct {
+	ID         types.String `tfsdk:"id"`
+	PolicyARNs types.Set    `tfsdk:"policy_arns"`
+	UserName   types.String `tfsdk:"user_name"`
+}
+
+func (model *userPolicyAttachmentsExclusiveResourceModel) InitFromID() error {
+	model.UserName = model.ID
+
+	return nil
+}
+
+func (model *userPolicyAttachmentsExclusiveResourceModel) setID() {
+	model.ID = model.UserName
+}
@@ -41,6 +41,7 @@ const (
 
 // @SDKResource("aws_lambda_function", name="Function")
 // @Tags(identifierAttribute="arn")
+// @ARNImport(template="arn:{partition}:lambda:{region}:{account_id}:function:{function_name}", id="{function_name}")
 func resourceFunction() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceFunctionCreate,
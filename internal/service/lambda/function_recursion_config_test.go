@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaFunctionRecursionConfig_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v lambda.GetFunctionRecursionConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_function_recursion_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckFunctionRecursionConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccFunctionRecursionConfigConfig_basic(rName, string(awstypes.RecursiveLoopAllow)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionRecursionConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "function_name", "aws_lambda_function.test", "function_name"),
+					resource.TestCheckResourceAttr(resourceName, "recursive_loop", string(awstypes.RecursiveLoopAllow)),
+				),
+			},
+			{
+				ResourceName:                         resourceName,
+				ImportState:                          true,
+				ImportStateVerify:                    true,
+				ImportStateIdFunc:                    acctest.AttrImportStateIdFunc(resourceName, "function_name"),
+				ImportStateVerifyIdentifierAttribute: "function_name",
+			},
+			{
+				Config: testAccFunctionRecursionConfigConfig_basic(rName, string(awstypes.RecursiveLoopTerminate)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckFunctionRecursionConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "recursive_loop", string(awstypes.RecursiveLoopTerminate)),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckFunctionRecursionConfigDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lambda_function_recursion_config" {
+				continue
+			}
+
+			output, err := tflambda.FindFunctionRecursionConfigByName(ctx, conn, rs.Primary.Attributes["function_name"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			// Deleting the resource resets the configuration to the default.
+			if output.RecursiveLoop == awstypes.RecursiveLoopTerminate {
+				continue
+			}
+
+			return fmt.Errorf("Lambda Function Recursion Config %s still exists", rs.Primary.Attributes["function_name"])
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckFunctionRecursionConfigExists(ctx context.Context, n string, v *lambda.GetFunctionRecursionConfigOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		output, err := tflambda.FindFunctionRecursionConfigByName(ctx, conn, rs.Primary.Attributes["function_name"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccFunctionRecursionConfigConfig_basic(rName, recursiveLoop string) string {
+	return acctest.ConfigCompose(acctest.ConfigLambdaBase(rName, rName, rName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+}
+
+resource "aws_lambda_function_recursion_config" "test" {
+  function_name  = aws_lambda_function.test.function_name
+  recursive_loop = %[2]q
+}
+`, rName, recursiveLoop))
+}
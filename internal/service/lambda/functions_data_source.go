@@ -8,10 +8,12 @@ import (
 
 	"github.com/aws/aws-sdk-go-v2/aws"
 	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/query"
 )
 
 // @SDKDataSource("aws_lambda_functions", name="Functions")
@@ -30,6 +32,7 @@ func dataSourceFunctions() *schema.Resource {
 				Computed: true,
 				Elem:     &schema.Schema{Type: schema.TypeString},
 			},
+			"query": query.Schema(),
 		},
 	}
 }
@@ -38,8 +41,7 @@ func dataSourceFunctionsRead(ctx context.Context, d *schema.ResourceData, meta i
 	var diags diag.Diagnostics
 	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
 
-	var functionARNs []string
-	var functionNames []string
+	var functions []awstypes.FunctionConfiguration
 
 	input := &lambda.ListFunctionsInput{}
 	pages := lambda.NewListFunctionsPaginator(conn, input)
@@ -50,10 +52,21 @@ func dataSourceFunctionsRead(ctx context.Context, d *schema.ResourceData, meta i
 			return sdkdiag.AppendErrorf(diags, "listing Lambda Functions: %s", err)
 		}
 
-		for _, v := range page.Functions {
-			functionARNs = append(functionARNs, aws.ToString(v.FunctionArn))
-			functionNames = append(functionNames, aws.ToString(v.FunctionName))
-		}
+		functions = append(functions, page.Functions...)
+	}
+
+	functions, err := query.Filter(d.Get("query").(string), functions)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Lambda Functions: %s", err)
+	}
+
+	var functionARNs []string
+	var functionNames []string
+
+	for _, v := range functions {
+		functionARNs = append(functionARNs, aws.ToString(v.FunctionArn))
+		functionNames = append(functionNames, aws.ToString(v.FunctionName))
 	}
 
 	d.SetId(meta.(*conns.AWSClient).Region)
@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKDataSource("aws_lambda_invocation", name="Invocation")
+// @NoMemoize
 func dataSourceInvocation() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceInvocationRead,
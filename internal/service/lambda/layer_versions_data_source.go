@@ -0,0 +1,164 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/enum"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/sdkdiag"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @SDKDataSource("aws_lambda_layer_versions", name="Layer Versions")
+func dataSourceLayerVersions() *schema.Resource {
+	return &schema.Resource{
+		ReadWithoutTimeout: dataSourceLayerVersionsRead,
+
+		Schema: map[string]*schema.Schema{
+			"compatible_architecture": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.Architecture](),
+			},
+			"compatible_runtime": {
+				Type:             schema.TypeString,
+				Optional:         true,
+				ValidateDiagFunc: enum.Validate[awstypes.Runtime](),
+			},
+			"layer_name": {
+				Type:     schema.TypeString,
+				Required: true,
+			},
+			"layer_versions": {
+				Type:     schema.TypeList,
+				Computed: true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						names.AttrARN: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"compatible_architectures": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						"compatible_runtimes": {
+							Type:     schema.TypeSet,
+							Computed: true,
+							Elem:     &schema.Schema{Type: schema.TypeString},
+						},
+						names.AttrCreatedDate: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrDescription: {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"license_info": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						names.AttrVersion: {
+							Type:     schema.TypeInt,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"max_items": {
+				Type:         schema.TypeInt,
+				Optional:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+		},
+	}
+}
+
+func dataSourceLayerVersionsRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var diags diag.Diagnostics
+	conn := meta.(*conns.AWSClient).LambdaClient(ctx)
+
+	layerName := d.Get("layer_name").(string)
+	input := &lambda.ListLayerVersionsInput{
+		LayerName: aws.String(layerName),
+	}
+
+	if v, ok := d.GetOk("compatible_architecture"); ok {
+		input.CompatibleArchitecture = awstypes.Architecture(v.(string))
+	}
+
+	if v, ok := d.GetOk("compatible_runtime"); ok {
+		input.CompatibleRuntime = awstypes.Runtime(v.(string))
+	}
+
+	// Versions are returned newest first.
+	maxItems := d.Get("max_items").(int)
+	layerVersions, err := findLayerVersions(ctx, conn, input, maxItems)
+
+	if err != nil {
+		return sdkdiag.AppendErrorf(diags, "listing Lambda Layer Versions (%s): %s", layerName, err)
+	}
+
+	d.SetId(layerName)
+	if err := d.Set("layer_versions", flattenLayerVersionsListItems(layerVersions)); err != nil {
+		return sdkdiag.AppendErrorf(diags, "setting layer_versions: %s", err)
+	}
+
+	return diags
+}
+
+func findLayerVersions(ctx context.Context, conn *lambda.Client, input *lambda.ListLayerVersionsInput, maxItems int) ([]awstypes.LayerVersionsListItem, error) {
+	var output []awstypes.LayerVersionsListItem
+
+	pages := lambda.NewListLayerVersionsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		output = append(output, page.LayerVersions...)
+
+		if maxItems > 0 && len(output) >= maxItems {
+			return output[:maxItems], nil
+		}
+	}
+
+	return output, nil
+}
+
+func flattenLayerVersionsListItems(apiObjects []awstypes.LayerVersionsListItem) []interface{} {
+	if len(apiObjects) == 0 {
+		return nil
+	}
+
+	var tfList []interface{}
+
+	for _, apiObject := range apiObjects {
+		tfMap := map[string]interface{}{
+			names.AttrARN:              aws.ToString(apiObject.LayerVersionArn),
+			"compatible_architectures": apiObject.CompatibleArchitectures,
+			"compatible_runtimes":      apiObject.CompatibleRuntimes,
+			names.AttrCreatedDate:      aws.ToString(apiObject.CreatedDate),
+			names.AttrDescription:      aws.ToString(apiObject.Description),
+			"license_info":             aws.ToString(apiObject.LicenseInfo),
+			names.AttrVersion:          apiObject.Version,
+		}
+
+		tfList = append(tfList, tfMap)
+	}
+
+	return tfList
+}
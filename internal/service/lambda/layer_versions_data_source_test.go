@@ -0,0 +1,167 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"fmt"
+	"testing"
+
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaLayerVersionsDataSource_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_layer_versions.test"
+	resourceName1 := "aws_lambda_layer_version.test1"
+	resourceName2 := "aws_lambda_layer_version.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionsDataSourceConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.#", "2"),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.0.arn", resourceName2, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.0.created_date", resourceName2, names.AttrCreatedDate),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.0.description", resourceName2, names.AttrDescription),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.0.version", resourceName2, names.AttrVersion),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.1.arn", resourceName1, names.AttrARN),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.1.version", resourceName1, names.AttrVersion),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaLayerVersionsDataSource_maxItems(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_layer_versions.test"
+	resourceName := "aws_lambda_layer_version.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionsDataSourceConfig_maxItems(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.#", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.0.version", resourceName, names.AttrVersion),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaLayerVersionsDataSource_compatibleRuntime(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_layer_versions.test"
+	resourceName := "aws_lambda_layer_version.test1"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionsDataSourceConfig_compatibleRuntime(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.#", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.0.version", resourceName, names.AttrVersion),
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.0.compatible_runtimes.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "layer_versions.0.compatible_runtimes.*", "python3.12"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaLayerVersionsDataSource_compatibleArchitecture(t *testing.T) {
+	ctx := acctest.Context(t)
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	dataSourceName := "data.aws_lambda_layer_versions.test"
+	resourceName := "aws_lambda_layer_version.test2"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLayerVersionsDataSourceConfig_compatibleArchitecture(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.#", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(dataSourceName, "layer_versions.0.version", resourceName, names.AttrVersion),
+					resource.TestCheckResourceAttr(dataSourceName, "layer_versions.0.compatible_architectures.#", acctest.CtOne),
+					resource.TestCheckTypeSetElemAttr(dataSourceName, "layer_versions.0.compatible_architectures.*", "arm64"),
+				),
+			},
+		},
+	})
+}
+
+func testAccLayerVersionsDataSourceConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_lambda_layer_version" "test1" {
+  filename                 = "test-fixtures/lambdatest.zip"
+  layer_name               = %[1]q
+  description              = "first"
+  compatible_runtimes      = ["python3.12"]
+  compatible_architectures = ["x86_64"]
+}
+
+resource "aws_lambda_layer_version" "test2" {
+  filename                 = "test-fixtures/lambdatest_modified.zip"
+  layer_name               = aws_lambda_layer_version.test1.layer_name
+  description              = "second"
+  compatible_runtimes      = ["nodejs20.x"]
+  compatible_architectures = ["arm64"]
+}
+`, rName)
+}
+
+func testAccLayerVersionsDataSourceConfig_basic(rName string) string {
+	return acctest.ConfigCompose(testAccLayerVersionsDataSourceConfig_base(rName), `
+data "aws_lambda_layer_versions" "test" {
+  layer_name = aws_lambda_layer_version.test2.layer_name
+}
+`)
+}
+
+func testAccLayerVersionsDataSourceConfig_maxItems(rName string) string {
+	return acctest.ConfigCompose(testAccLayerVersionsDataSourceConfig_base(rName), `
+data "aws_lambda_layer_versions" "test" {
+  layer_name = aws_lambda_layer_version.test2.layer_name
+  max_items  = 1
+}
+`)
+}
+
+func testAccLayerVersionsDataSourceConfig_compatibleRuntime(rName string) string {
+	return acctest.ConfigCompose(testAccLayerVersionsDataSourceConfig_base(rName), `
+data "aws_lambda_layer_versions" "test" {
+  layer_name         = aws_lambda_layer_version.test2.layer_name
+  compatible_runtime = "python3.12"
+}
+`)
+}
+
+func testAccLayerVersionsDataSourceConfig_compatibleArchitecture(rName string) string {
+	return acctest.ConfigCompose(testAccLayerVersionsDataSourceConfig_base(rName), `
+data "aws_lambda_layer_versions" "test" {
+  layer_name              = aws_lambda_layer_version.test2.layer_name
+  compatible_architecture = "arm64"
+}
+`)
+}
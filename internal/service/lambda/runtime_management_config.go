@@ -0,0 +1,266 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Runtime Management Config")
+func newRuntimeManagementConfigResource(context.Context) (resource.ResourceWithConfigure, error) {
+	r := &runtimeManagementConfigResource{}
+
+	return r, nil
+}
+
+type runtimeManagementConfigResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*runtimeManagementConfigResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_lambda_runtime_management_config"
+}
+
+func (r *runtimeManagementConfigResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrFunctionARN: schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"function_name": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"qualifier": schema.StringAttribute{
+				Optional: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"runtime_version_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Optional:   true,
+			},
+			"update_runtime_on": schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.UpdateRuntimeOn](),
+				Required:   true,
+			},
+		},
+	}
+}
+
+func (r *runtimeManagementConfigResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data runtimeManagementConfigResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LambdaClient(ctx)
+
+	input := &lambda.PutRuntimeManagementConfigInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.setID()
+
+	output, err := conn.PutRuntimeManagementConfig(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Lambda Runtime Management Config (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	data.FunctionARN = fwflex.StringToFramework(ctx, output.FunctionArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *runtimeManagementConfigResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data runtimeManagementConfigResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().LambdaClient(ctx)
+
+	output, err := findRuntimeManagementConfigByTwoPartKey(ctx, conn, data.FunctionName.ValueString(), data.Qualifier.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Lambda Runtime Management Config (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *runtimeManagementConfigResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var new runtimeManagementConfigResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LambdaClient(ctx)
+
+	input := &lambda.PutRuntimeManagementConfigInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, new, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.PutRuntimeManagementConfig(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("updating Lambda Runtime Management Config (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	new.FunctionARN = fwflex.StringToFramework(ctx, output.FunctionArn)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *runtimeManagementConfigResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data runtimeManagementConfigResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().LambdaClient(ctx)
+
+	// Deleting the resource resets the function's runtime management configuration to the default.
+	input := &lambda.PutRuntimeManagementConfigInput{
+		FunctionName:    data.FunctionName.ValueStringPointer(),
+		UpdateRuntimeOn: awstypes.UpdateRuntimeOnAuto,
+	}
+	if !data.Qualifier.IsNull() && data.Qualifier.ValueString() != "" {
+		input.Qualifier = data.Qualifier.ValueStringPointer()
+	}
+
+	_, err := conn.PutRuntimeManagementConfig(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Lambda Runtime Management Config (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findRuntimeManagementConfigByTwoPartKey(ctx context.Context, conn *lambda.Client, functionName, qualifier string) (*lambda.GetRuntimeManagementConfigOutput, error) {
+	input := &lambda.GetRuntimeManagementConfigInput{
+		FunctionName: aws.String(functionName),
+	}
+	if qualifier != "" {
+		input.Qualifier = aws.String(qualifier)
+	}
+
+	output, err := conn.GetRuntimeManagementConfig(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+type runtimeManagementConfigResourceModel struct {
+	FunctionARN       types.String                                 `tfsdk:"function_arn"`
+	FunctionName      types.String                                 `tfsdk:"function_name"`
+	ID                types.String                                 `tfsdk:"id"`
+	Qualifier         types.String                                 `tfsdk:"qualifier"`
+	RuntimeVersionARN fwtypes.ARN                                  `tfsdk:"runtime_version_arn"`
+	UpdateRuntimeOn   fwtypes.StringEnum[awstypes.UpdateRuntimeOn] `tfsdk:"update_runtime_on"`
+}
+
+const (
+	runtimeManagementConfigResourceIDPartCount = 2
+)
+
+func (data *runtimeManagementConfigResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), runtimeManagementConfigResourceIDPartCount, true)
+	if err != nil {
+		return err
+	}
+
+	data.FunctionName = types.StringValue(parts[0])
+	if parts[1] != "" {
+		data.Qualifier = types.StringValue(parts[1])
+	} else {
+		data.Qualifier = types.StringNull()
+	}
+
+	return nil
+}
+
+func (data *runtimeManagementConfigResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.FunctionName.ValueString(), data.Qualifier.ValueString()}, runtimeManagementConfigResourceIDPartCount, true)))
+}
@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package lambda_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/aws/aws-sdk-go-v2/service/lambda"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/lambda/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tflambda "github.com/hashicorp/terraform-provider-aws/internal/service/lambda"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccLambdaRuntimeManagementConfig_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v lambda.GetRuntimeManagementConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_runtime_management_config.test"
+	functionResourceName := "aws_lambda_function.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuntimeManagementConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimeManagementConfigConfig_basic(rName, string(awstypes.UpdateRuntimeOnFunctionUpdate)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuntimeManagementConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrFunctionARN, functionResourceName, names.AttrARN),
+					resource.TestCheckResourceAttrPair(resourceName, "function_name", functionResourceName, "function_name"),
+					resource.TestCheckNoResourceAttr(resourceName, "qualifier"),
+					resource.TestCheckNoResourceAttr(resourceName, "runtime_version_arn"),
+					resource.TestCheckResourceAttr(resourceName, "update_runtime_on", string(awstypes.UpdateRuntimeOnFunctionUpdate)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccRuntimeManagementConfigConfig_basic(rName, string(awstypes.UpdateRuntimeOnAuto)),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuntimeManagementConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "update_runtime_on", string(awstypes.UpdateRuntimeOnAuto)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccLambdaRuntimeManagementConfig_qualifier(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v lambda.GetRuntimeManagementConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_runtime_management_config.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuntimeManagementConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimeManagementConfigConfig_qualifier(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuntimeManagementConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttrPair(resourceName, "qualifier", "aws_lambda_function.test", names.AttrVersion),
+					resource.TestCheckResourceAttr(resourceName, "update_runtime_on", string(awstypes.UpdateRuntimeOnFunctionUpdate)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccLambdaRuntimeManagementConfig_runtimeVersionARN(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v lambda.GetRuntimeManagementConfigOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_lambda_runtime_management_config.test"
+
+	// Runtime version ARNs are opaque and region-specific, so one for the
+	// nodejs20.x runtime in the test region must be supplied.
+	key := "LAMBDA_RUNTIME_VERSION_ARN"
+	runtimeVersionARN := os.Getenv(key)
+	if runtimeVersionARN == "" {
+		t.Skipf("Environment variable %s is not set", key)
+	}
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.LambdaServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckRuntimeManagementConfigDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccRuntimeManagementConfigConfig_runtimeVersionARN(rName, runtimeVersionARN),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckRuntimeManagementConfigExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "runtime_version_arn", runtimeVersionARN),
+					resource.TestCheckResourceAttr(resourceName, "update_runtime_on", string(awstypes.UpdateRuntimeOnManual)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckRuntimeManagementConfigDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_lambda_runtime_management_config" {
+				continue
+			}
+
+			output, err := tflambda.FindRuntimeManagementConfigByTwoPartKey(ctx, conn, rs.Primary.Attributes["function_name"], rs.Primary.Attributes["qualifier"])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			// Deleting the resource resets the configuration to the default.
+			if output.UpdateRuntimeOn == awstypes.UpdateRuntimeOnAuto {
+				continue
+			}
+
+			return fmt.Errorf("Lambda Runtime Management Config %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckRuntimeManagementConfigExists(ctx context.Context, n string, v *lambda.GetRuntimeManagementConfigOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).LambdaClient(ctx)
+
+		output, err := tflambda.FindRuntimeManagementConfigByTwoPartKey(ctx, conn, rs.Primary.Attributes["function_name"], rs.Primary.Attributes["qualifier"])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccRuntimeManagementConfigConfig_base(rName string) string {
+	return acctest.ConfigCompose(acctest.ConfigLambdaBase(rName, rName, rName), fmt.Sprintf(`
+resource "aws_lambda_function" "test" {
+  filename      = "test-fixtures/lambdatest.zip"
+  function_name = %[1]q
+  role          = aws_iam_role.iam_for_lambda.arn
+  handler       = "exports.example"
+  runtime       = "nodejs20.x"
+  publish       = true
+}
+`, rName))
+}
+
+func testAccRuntimeManagementConfigConfig_basic(rName, updateRuntimeOn string) string {
+	return acctest.ConfigCompose(testAccRuntimeManagementConfigConfig_base(rName), fmt.Sprintf(`
+resource "aws_lambda_runtime_management_config" "test" {
+  function_name     = aws_lambda_function.test.function_name
+  update_runtime_on = %[1]q
+}
+`, updateRuntimeOn))
+}
+
+func testAccRuntimeManagementConfigConfig_qualifier(rName string) string {
+	return acctest.ConfigCompose(testAccRuntimeManagementConfigConfig_base(rName), `
+resource "aws_lambda_runtime_management_config" "test" {
+  function_name     = aws_lambda_function.test.function_name
+  qualifier         = aws_lambda_function.test.version
+  update_runtime_on = "FunctionUpdate"
+}
+`)
+}
+
+func testAccRuntimeManagementConfigConfig_runtimeVersionARN(rName, runtimeVersionARN string) string {
+	return acctest.ConfigCompose(testAccRuntimeManagementConfigConfig_base(rName), fmt.Sprintf(`
+resource "aws_lambda_runtime_management_config" "test" {
+  function_name       = aws_lambda_function.test.function_name
+  runtime_version_arn = %[1]q
+  update_runtime_on   = "Manual"
+}
+`, runtimeVersionARN))
+}
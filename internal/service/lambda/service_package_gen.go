@@ -19,7 +19,16 @@ func (p *servicePackage) FrameworkDataSources(ctx context.Context) []*types.Serv
 }
 
 func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.ServicePackageFrameworkResource {
-	return []*types.ServicePackageFrameworkResource{}
+	return []*types.ServicePackageFrameworkResource{
+		{
+			Factory: newFunctionRecursionConfigResource,
+			Name:    "Function Recursion Config",
+		},
+		{
+			Factory: newRuntimeManagementConfigResource,
+			Name:    "Runtime Management Config",
+		},
+	}
 }
 
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
@@ -50,15 +59,21 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Name:     "Functions",
 		},
 		{
-			Factory:  dataSourceInvocation,
-			TypeName: "aws_lambda_invocation",
-			Name:     "Invocation",
+			Factory:   dataSourceInvocation,
+			TypeName:  "aws_lambda_invocation",
+			Name:      "Invocation",
+			NoMemoize: true,
 		},
 		{
 			Factory:  dataSourceLayerVersion,
 			TypeName: "aws_lambda_layer_version",
 			Name:     "Layer Version",
 		},
+		{
+			Factory:  dataSourceLayerVersions,
+			TypeName: "aws_lambda_layer_versions",
+			Name:     "Layer Versions",
+		},
 	}
 }
 
@@ -86,6 +101,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 			Tags: &types.ServicePackageResourceTags{
 				IdentifierAttribute: names.AttrARN,
 			},
+			ARN: &types.ServicePackageResourceARN{
+				Template:   "arn:{partition}:lambda:{region}:{account_id}:function:{function_name}",
+				IDTemplate: "{function_name}",
+			},
 		},
 		{
 			Factory:  resourceFunctionEventInvokeConfig,
@@ -0,0 +1,76 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package logs
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+)
+
+// @FrameworkDataSource(name="Delivery Destination Policy")
+func newDeliveryDestinationPolicyDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &deliveryDestinationPolicyDataSource{}
+
+	return d, nil
+}
+
+type deliveryDestinationPolicyDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*deliveryDestinationPolicyDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_cloudwatch_log_delivery_destination_policy"
+}
+
+func (d *deliveryDestinationPolicyDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"delivery_destination_name": schema.StringAttribute{
+				Required: true,
+			},
+			"delivery_destination_policy": schema.StringAttribute{
+				CustomType: fwtypes.IAMPolicyType,
+				Computed:   true,
+			},
+		},
+	}
+}
+
+func (d *deliveryDestinationPolicyDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data deliveryDestinationPolicyDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().LogsClient(ctx)
+
+	output, err := findDeliveryDestinationPolicyByDeliveryDestinationName(ctx, conn, data.DeliveryDestinationName.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading CloudWatch Logs Delivery Destination Policy (%s)", data.DeliveryDestinationName.ValueString()), tfresource.SingularDataSourceFindError("CloudWatch Logs Delivery Destination Policy", err).Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+type deliveryDestinationPolicyDataSourceModel struct {
+	DeliveryDestinationName   types.String      `tfsdk:"delivery_destination_name"`
+	DeliveryDestinationPolicy fwtypes.IAMPolicy `tfsdk:"delivery_destination_policy"`
+}
@@ -5,21 +5,35 @@ package logs
 
 // Exports for use in tests only.
 var (
-	ResourceDataProtectionPolicy = resourceDataProtectionPolicy
-	ResourceDestination          = resourceDestination
-	ResourceDestinationPolicy    = resourceDestinationPolicy
-	ResourceGroup                = resourceGroup
-	ResourceMetricFilter         = resourceMetricFilter
-	ResourceQueryDefinition      = resourceQueryDefinition
-	ResourceResourcePolicy       = resourceResourcePolicy
-	ResourceStream               = resourceStream
-	ResourceSubscriptionFilter   = resourceSubscriptionFilter
+	ResourceAccountPolicy             = resourceAccountPolicy
+	ResourceAnomalyDetector           = newAnomalyDetectorResource
+	ResourceDataProtectionPolicy      = resourceDataProtectionPolicy
+	ResourceDelivery                  = newDeliveryResource
+	ResourceDeliveryDestination       = newDeliveryDestinationResource
+	ResourceDeliveryDestinationPolicy = newDeliveryDestinationPolicyResource
+	ResourceDeliverySource            = newDeliverySourceResource
+	ResourceDestination               = resourceDestination
+	ResourceDestinationPolicy         = resourceDestinationPolicy
+	ResourceGroup                     = resourceGroup
+	ResourceIndexPolicy               = newIndexPolicyResource
+	ResourceMetricFilter              = resourceMetricFilter
+	ResourceQueryDefinition           = resourceQueryDefinition
+	ResourceResourcePolicy            = resourceResourcePolicy
+	ResourceStream                    = resourceStream
+	ResourceSubscriptionFilter        = resourceSubscriptionFilter
 
-	FindDestinationByName              = findDestinationByName
-	FindLogGroupByName                 = findLogGroupByName
-	FindLogStreamByTwoPartKey          = findLogStreamByTwoPartKey // nosemgrep:ci.logs-in-var-name
-	FindMetricFilterByTwoPartKey       = findMetricFilterByTwoPartKey
-	FindQueryDefinitionByTwoPartKey    = findQueryDefinitionByTwoPartKey
-	FindResourcePolicyByName           = findResourcePolicyByName
-	FindSubscriptionFilterByTwoPartKey = findSubscriptionFilterByTwoPartKey
+	FindAccountPolicyByTwoPartKey                          = findAccountPolicyByTwoPartKey
+	FindAnomalyDetectorByARN                               = findAnomalyDetectorByARN
+	FindDeliveryByID                                       = findDeliveryByID
+	FindDeliveryDestinationByName                          = findDeliveryDestinationByName
+	FindDeliveryDestinationPolicyByDeliveryDestinationName = findDeliveryDestinationPolicyByDeliveryDestinationName
+	FindDeliverySourceByName                               = findDeliverySourceByName
+	FindDestinationByName                                  = findDestinationByName
+	FindIndexPolicyByLogGroupName                          = findIndexPolicyByLogGroupName
+	FindLogGroupByName                                     = findLogGroupByName
+	FindLogStreamByTwoPartKey                              = findLogStreamByTwoPartKey // nosemgrep:ci.logs-in-var-name
+	FindMetricFilterByTwoPartKey                           = findMetricFilterByTwoPartKey
+	FindQueryDefinitionByTwoPartKey                        = findQueryDefinitionByTwoPartKey
+	FindResourcePolicyByName                               = findResourcePolicyByName
+	FindSubscriptionFilterByTwoPartKey                     = findSubscriptionFilterByTwoPartKey
 )
@@ -27,6 +27,7 @@ import (
 
 // @SDKResource("aws_cloudwatch_log_group", name="Log Group")
 // @Tags
+// @ARNImport(template="arn:{partition}:logs:{region}:{account_id}:log-group:{name}", id="{name}")
 func resourceGroup() *schema.Resource {
 	return &schema.Resource{
 		CreateWithoutTimeout: resourceGroupCreate,
@@ -44,3 +44,20 @@ func describeResourcePoliciesPages(ctx context.Context, conn *cloudwatchlogs.Cli
 	}
 	return nil
 }
+
+func describeIndexPoliciesPages(ctx context.Context, conn *cloudwatchlogs.Client, input *cloudwatchlogs.DescribeIndexPoliciesInput, fn func(*cloudwatchlogs.DescribeIndexPoliciesOutput, bool) bool) error {
+	for {
+		output, err := conn.DescribeIndexPolicies(ctx, input)
+		if err != nil {
+			return err
+		}
+
+		lastPage := aws.ToString(output.NextToken) == ""
+		if !fn(output, lastPage) || lastPage {
+			break
+		}
+
+		input.NextToken = output.NextToken
+	}
+	return nil
+}
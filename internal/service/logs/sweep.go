@@ -12,9 +12,37 @@ import (
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
+	resource.AddTestSweepers("aws_cloudwatch_log_anomaly_detector", &resource.Sweeper{
+		Name: "aws_cloudwatch_log_anomaly_detector",
+		F:    sweepAnomalyDetectors,
+	})
+
+	resource.AddTestSweepers("aws_cloudwatch_log_delivery", &resource.Sweeper{
+		Name: "aws_cloudwatch_log_delivery",
+		F:    sweepDeliveries,
+	})
+
+	resource.AddTestSweepers("aws_cloudwatch_log_delivery_destination", &resource.Sweeper{
+		Name: "aws_cloudwatch_log_delivery_destination",
+		F:    sweepDeliveryDestinations,
+		Dependencies: []string{
+			"aws_cloudwatch_log_delivery",
+		},
+	})
+
+	resource.AddTestSweepers("aws_cloudwatch_log_delivery_source", &resource.Sweeper{
+		Name: "aws_cloudwatch_log_delivery_source",
+		F:    sweepDeliverySources,
+		Dependencies: []string{
+			"aws_cloudwatch_log_delivery",
+		},
+	})
+
 	resource.AddTestSweepers("aws_cloudwatch_log_group", &resource.Sweeper{
 		Name: "aws_cloudwatch_log_group",
 		F:    sweepGroups,
@@ -181,3 +209,159 @@ func sweepResourcePolicies(region string) error {
 
 	return nil
 }
+
+func sweepDeliveries(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	input := &cloudwatchlogs.DescribeDeliveriesInput{}
+	conn := client.LogsClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := cloudwatchlogs.NewDescribeDeliveriesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping CloudWatch Logs Delivery sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudWatch Logs Deliveries (%s): %w", region, err)
+		}
+
+		for _, v := range page.Deliveries {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newDeliveryResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Id)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudWatch Logs Deliveries (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDeliveryDestinations(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	input := &cloudwatchlogs.DescribeDeliveryDestinationsInput{}
+	conn := client.LogsClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := cloudwatchlogs.NewDescribeDeliveryDestinationsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping CloudWatch Logs Delivery Destination sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudWatch Logs Delivery Destinations (%s): %w", region, err)
+		}
+
+		for _, v := range page.DeliveryDestinations {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newDeliveryDestinationResource, client,
+				framework.NewAttribute(names.AttrName, aws.ToString(v.Name)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudWatch Logs Delivery Destinations (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepDeliverySources(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	input := &cloudwatchlogs.DescribeDeliverySourcesInput{}
+	conn := client.LogsClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := cloudwatchlogs.NewDescribeDeliverySourcesPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping CloudWatch Logs Delivery Source sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudWatch Logs Delivery Sources (%s): %w", region, err)
+		}
+
+		for _, v := range page.DeliverySources {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newDeliverySourceResource, client,
+				framework.NewAttribute(names.AttrName, aws.ToString(v.Name)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudWatch Logs Delivery Sources (%s): %w", region, err)
+	}
+
+	return nil
+}
+
+func sweepAnomalyDetectors(region string) error {
+	ctx := sweep.Context(region)
+	client, err := sweep.SharedRegionalSweepClient(ctx, region)
+	if err != nil {
+		return fmt.Errorf("getting client: %s", err)
+	}
+	input := &cloudwatchlogs.ListLogAnomalyDetectorsInput{}
+	conn := client.LogsClient(ctx)
+	sweepResources := make([]sweep.Sweepable, 0)
+
+	pages := cloudwatchlogs.NewListLogAnomalyDetectorsPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			log.Printf("[WARN] Skipping CloudWatch Logs Anomaly Detector sweep for %s: %s", region, err)
+			return nil
+		}
+
+		if err != nil {
+			return fmt.Errorf("error listing CloudWatch Logs Anomaly Detectors (%s): %w", region, err)
+		}
+
+		for _, v := range page.AnomalyDetectors {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newAnomalyDetectorResource, client,
+				framework.NewAttribute(names.AttrARN, aws.ToString(v.AnomalyDetectorArn)),
+			))
+		}
+	}
+
+	err = sweep.SweepOrchestrator(ctx, sweepResources)
+
+	if err != nil {
+		return fmt.Errorf("error sweeping CloudWatch Logs Anomaly Detectors (%s): %w", region, err)
+	}
+
+	return nil
+}
@@ -42,6 +42,18 @@ func ClusterStatus_Values() []string {
 	}
 }
 
+const (
+	ClusterEngineRedis  = "redis"
+	ClusterEngineValkey = "valkey"
+)
+
+func ClusterEngine_Values() []string {
+	return []string{
+		ClusterEngineRedis,
+		ClusterEngineValkey,
+	}
+}
+
 const (
 	ClusterParameterGroupStatusApplying = "applying"
 	ClusterParameterGroupStatusInSync   = "in-sync"
@@ -94,6 +106,22 @@ func ClusterSNSTopicStatus_Values() []string {
 	}
 }
 
+const (
+	MultiRegionClusterStatusAvailable = "available"
+	MultiRegionClusterStatusCreating  = "creating"
+	MultiRegionClusterStatusDeleting  = "deleting"
+	MultiRegionClusterStatusUpdating  = "updating"
+)
+
+func MultiRegionClusterStatus_Values() []string {
+	return []string{
+		MultiRegionClusterStatusAvailable,
+		MultiRegionClusterStatusCreating,
+		MultiRegionClusterStatusDeleting,
+		MultiRegionClusterStatusUpdating,
+	}
+}
+
 const (
 	SnapshotStatusAvailable = "available"
 	SnapshotStatusCopying   = "copying"
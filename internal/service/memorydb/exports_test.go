@@ -0,0 +1,11 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package memorydb
+
+// Exports for use in tests only.
+var (
+	ResourceMultiRegionCluster = newMultiRegionClusterResource
+
+	FindMultiRegionClusterByName = findMultiRegionClusterByName
+)
@@ -4,15 +4,23 @@
 package memorydb
 
 import (
+	"context"
 	"fmt"
 	"log"
 	"strings"
 
+	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	memorydb_sdkv2 "github.com/aws/aws-sdk-go-v2/service/memorydb"
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/memorydb"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
 	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv1"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
 )
 
 func RegisterSweepers() {
@@ -29,6 +37,8 @@ func RegisterSweepers() {
 		F:    sweepClusters,
 	})
 
+	sweep.Register("aws_memorydb_multi_region_cluster", sweepMultiRegionClusters, "aws_memorydb_cluster")
+
 	resource.AddTestSweepers("aws_memorydb_parameter_group", &resource.Sweeper{
 		Name: "aws_memorydb_parameter_group",
 		F:    sweepParameterGroups,
@@ -156,6 +166,36 @@ func sweepClusters(region string) error {
 	return nil
 }
 
+func sweepMultiRegionClusters(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.MemoryDBClient(ctx)
+	input := &memorydb_sdkv2.DescribeMultiRegionClustersInput{}
+	var sweepResources []sweep.Sweepable
+
+	pages := memorydb_sdkv2.NewDescribeMultiRegionClustersPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+				"error": err.Error(),
+			})
+			return nil, nil
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.MultiRegionClusters {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newMultiRegionClusterResource, client,
+				framework.NewAttribute(names.AttrID, aws_sdkv2.ToString(v.MultiRegionClusterName)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
+
 func sweepParameterGroups(region string) error {
 	ctx := sweep.Context(region)
 	client, err := sweep.SharedRegionalSweepClient(ctx, region)
@@ -151,6 +151,12 @@ func (h *instanceHandler) modifyTarget(ctx context.Context, identifier string, d
 
 	needsModify := dbInstancePopulateModify(modifyInput, d)
 
+	if ok, err := dbInstancePopulateModifyWriteOnly(modifyInput, d); err != nil {
+		return err
+	} else if ok {
+		needsModify = true
+	}
+
 	if needsModify {
 		log.Printf("[DEBUG] %s: Updating Green environment", operation)
 
@@ -37,6 +37,13 @@ const (
 	ClusterSnapshotStatusCreating  = "creating"
 )
 
+const (
+	shardGroupStatusAvailable = "available"
+	shardGroupStatusCreating  = "creating"
+	shardGroupStatusDeleting  = "deleting"
+	shardGroupStatusModifying = "modifying"
+)
+
 const (
 	storageTypeStandard    = "standard"
 	storageTypeGP2         = "gp2"
@@ -5,11 +5,14 @@ package rds
 
 // Exports for use in tests only.
 var (
+	ResourceClusterSnapshotCopy     = resourceClusterSnapshotCopy
 	ResourceEventSubscription       = resourceEventSubscription
+	ResourceIntegration             = newIntegrationResource
 	ResourceProxy                   = resourceProxy
 	ResourceProxyDefaultTargetGroup = resourceProxyDefaultTargetGroup
 	ResourceProxyEndpoint           = resourceProxyEndpoint
 	ResourceProxyTarget             = resourceProxyTarget
+	ResourceShardGroup              = newShardGroupResource
 	ResourceSubnetGroup             = resourceSubnetGroup
 
 	FindDBInstanceByID                         = findDBInstanceByIDSDKv1
@@ -19,6 +22,8 @@ var (
 	FindDBSubnetGroupByName                    = findDBSubnetGroupByName
 	FindDefaultDBProxyTargetGroupByDBProxyName = findDefaultDBProxyTargetGroupByDBProxyName
 	FindEventSubscriptionByID                  = findEventSubscriptionByID
+	FindIntegrationByARN                       = findIntegrationByARN
+	FindShardGroupByID                         = findShardGroupByID
 	ListTags                                   = listTags
 	NewBlueGreenOrchestrator                   = newBlueGreenOrchestrator
 	ParseDBInstanceARN                         = parseDBInstanceARN
@@ -19,6 +19,7 @@ import (
 )
 
 // @SDKDataSource("aws_redshift_cluster_credentials", name="Cluster Credentials")
+// @NoMemoize
 func dataSourceClusterCredentials() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceClusterCredentialsRead,
@@ -60,9 +60,10 @@ func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePac
 			Tags:     &types.ServicePackageResourceTags{},
 		},
 		{
-			Factory:  dataSourceClusterCredentials,
-			TypeName: "aws_redshift_cluster_credentials",
-			Name:     "Cluster Credentials",
+			Factory:   dataSourceClusterCredentials,
+			TypeName:  "aws_redshift_cluster_credentials",
+			Name:      "Cluster Credentials",
+			NoMemoize: true,
 		},
 		{
 			Factory:  dataSourceOrderableCluster,
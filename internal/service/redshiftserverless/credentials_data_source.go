@@ -17,6 +17,7 @@ import (
 )
 
 // @SDKDataSource("aws_redshiftserverless_credentials", name="Credentials")
+// @NoMemoize
 func dataSourceCredentials() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceCredentialsRead,
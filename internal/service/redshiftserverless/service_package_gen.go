@@ -33,9 +33,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
 		{
-			Factory:  dataSourceCredentials,
-			TypeName: "aws_redshiftserverless_credentials",
-			Name:     "Credentials",
+			Factory:   dataSourceCredentials,
+			TypeName:  "aws_redshiftserverless_credentials",
+			Name:      "Credentials",
+			NoMemoize: true,
 		},
 		{
 			Factory:  dataSourceNamespace,
@@ -52,5 +52,8 @@ func hostedZoneIDForRegion(region string) (string, error) {
 	if v, ok := hostedZoneIDsMap[region]; ok {
 		return v, nil
 	}
+	if v, ok := names.HostedZoneIDForCustomRegion("s3", region); ok {
+		return v, nil
+	}
 	return "", fmt.Errorf("S3 website Route 53 hosted zone ID not found for Region (%s)", region)
 }
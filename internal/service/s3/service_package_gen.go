@@ -80,6 +80,10 @@ func (p *servicePackage) SDKResources(ctx context.Context) []*types.ServicePacka
 				IdentifierAttribute: names.AttrBucket,
 				ResourceType:        "Bucket",
 			},
+			ARN: &types.ServicePackageResourceARN{
+				Template:   "arn:{partition}:s3:::{bucket}",
+				IDTemplate: "{bucket}",
+			},
 		},
 		{
 			Factory:  resourceBucketAccelerateConfiguration,
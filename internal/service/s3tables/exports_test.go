@@ -0,0 +1,19 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables
+
+// Exports for use in tests only.
+var (
+	ResourceNamespace         = newNamespaceResource
+	ResourceTable             = newTableResource
+	ResourceTableBucket       = newTableBucketResource
+	ResourceTableBucketPolicy = newTableBucketPolicyResource
+	ResourceTablePolicy       = newTablePolicyResource
+
+	FindNamespaceByTwoPartKey     = findNamespaceByTwoPartKey
+	FindTableBucketByARN          = findTableBucketByARN
+	FindTableBucketPolicyByARN    = findTableBucketPolicyByARN
+	FindTableByThreePartKey       = findTableByThreePartKey
+	FindTablePolicyByThreePartKey = findTablePolicyByThreePartKey
+)
@@ -0,0 +1,157 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	sweep.Register("aws_s3tables_namespace", sweepNamespaces,
+		"aws_s3tables_table",
+	)
+
+	sweep.Register("aws_s3tables_table", sweepTables)
+
+	sweep.Register("aws_s3tables_table_bucket", sweepTableBuckets,
+		"aws_s3tables_namespace",
+	)
+}
+
+func sweepNamespaces(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.S3TablesClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	tableBucketARNs, err := listTableBucketARNs(ctx, conn)
+
+	if awsv2.SkipSweepError(err) {
+		tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+			"error": err.Error(),
+		})
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tableBucketARN := range tableBucketARNs {
+		pages := s3tables.NewListNamespacesPaginator(conn, &s3tables.ListNamespacesInput{
+			TableBucketARN: aws.String(tableBucketARN),
+		})
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, v := range page.Namespaces {
+				namespace := v.Namespace[0]
+
+				sweepResources = append(sweepResources, framework.NewSweepResource(newNamespaceResource, client,
+					framework.NewAttribute(names.AttrNamespace, namespace),
+					framework.NewAttribute("table_bucket_arn", tableBucketARN),
+				))
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepTables(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.S3TablesClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	tableBucketARNs, err := listTableBucketARNs(ctx, conn)
+
+	if awsv2.SkipSweepError(err) {
+		tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+			"error": err.Error(),
+		})
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tableBucketARN := range tableBucketARNs {
+		pages := s3tables.NewListTablesPaginator(conn, &s3tables.ListTablesInput{
+			TableBucketARN: aws.String(tableBucketARN),
+		})
+		for pages.HasMorePages() {
+			page, err := pages.NextPage(ctx)
+
+			if err != nil {
+				return nil, err
+			}
+
+			for _, v := range page.Tables {
+				sweepResources = append(sweepResources, framework.NewSweepResource(newTableResource, client,
+					framework.NewAttribute(names.AttrName, aws.ToString(v.Name)),
+					framework.NewAttribute(names.AttrNamespace, v.Namespace[0]),
+					framework.NewAttribute("table_bucket_arn", tableBucketARN),
+				))
+			}
+		}
+	}
+
+	return sweepResources, nil
+}
+
+func sweepTableBuckets(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.S3TablesClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	tableBucketARNs, err := listTableBucketARNs(ctx, conn)
+
+	if awsv2.SkipSweepError(err) {
+		tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+			"error": err.Error(),
+		})
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	for _, tableBucketARN := range tableBucketARNs {
+		sweepResources = append(sweepResources, framework.NewSweepResource(newTableBucketResource, client,
+			framework.NewAttribute(names.AttrID, tableBucketARN),
+		))
+	}
+
+	return sweepResources, nil
+}
+
+func listTableBucketARNs(ctx context.Context, conn *s3tables.Client) ([]string, error) {
+	var arns []string
+
+	pages := s3tables.NewListTableBucketsPaginator(conn, &s3tables.ListTableBucketsInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.TableBuckets {
+			arns = append(arns, aws.ToString(v.Arn))
+		}
+	}
+
+	return arns, nil
+}
@@ -0,0 +1,641 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/flex"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Table")
+func newTableResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &tableResource{}
+
+	return r, nil
+}
+
+type tableResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*tableResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_s3tables_table"
+}
+
+func (r *tableResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	computedString := func() schema.StringAttribute {
+		return schema.StringAttribute{
+			Computed: true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+	computedTimestamp := func() schema.StringAttribute {
+		return schema.StringAttribute{
+			CustomType: timetypes.RFC3339Type{},
+			Computed:   true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN:       framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: computedTimestamp(),
+			"created_by":        computedString(),
+			names.AttrFormat: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.OpenTableFormat](),
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"maintenance_configuration": schema.SingleNestedAttribute{
+				CustomType: fwtypes.NewObjectTypeOf[tableMaintenanceConfigurationModel](ctx),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"iceberg_compaction": schema.SingleNestedAttribute{
+						CustomType: fwtypes.NewObjectTypeOf[icebergCompactionModel](ctx),
+						Optional:   true,
+						Computed:   true,
+						PlanModifiers: []planmodifier.Object{
+							objectplanmodifier.UseStateForUnknown(),
+						},
+						Attributes: map[string]schema.Attribute{
+							"settings": schema.SingleNestedAttribute{
+								CustomType: fwtypes.NewObjectTypeOf[icebergCompactionSettingsModel](ctx),
+								Required:   true,
+								Attributes: map[string]schema.Attribute{
+									"target_file_size_mb": schema.Int64Attribute{
+										Required: true,
+										Validators: []validator.Int64{
+											int64validator.Between(64, 512),
+										},
+									},
+								},
+							},
+							names.AttrStatus: schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.MaintenanceStatus](),
+								Required:   true,
+							},
+						},
+					},
+					"iceberg_snapshot_management": schema.SingleNestedAttribute{
+						CustomType: fwtypes.NewObjectTypeOf[icebergSnapshotManagementModel](ctx),
+						Optional:   true,
+						Computed:   true,
+						PlanModifiers: []planmodifier.Object{
+							objectplanmodifier.UseStateForUnknown(),
+						},
+						Attributes: map[string]schema.Attribute{
+							"settings": schema.SingleNestedAttribute{
+								CustomType: fwtypes.NewObjectTypeOf[icebergSnapshotManagementSettingsModel](ctx),
+								Required:   true,
+								Attributes: map[string]schema.Attribute{
+									"max_snapshot_age_hours": schema.Int64Attribute{
+										Required: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+									"min_snapshots_to_keep": schema.Int64Attribute{
+										Required: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+								},
+							},
+							names.AttrStatus: schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.MaintenanceStatus](),
+								Required:   true,
+							},
+						},
+					},
+				},
+			},
+			"managed_by_service": computedString(),
+			"metadata_location":  computedString(),
+			"modified_at":        computedTimestamp(),
+			"modified_by":        computedString(),
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: tableNameValidator,
+			},
+			names.AttrNamespace: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: namespaceNameValidator,
+			},
+			"owner_account_id": computedString(),
+			"table_bucket_arn": schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrType: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.TableType](),
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"version_token":      computedString(),
+			"warehouse_location": computedString(),
+		},
+	}
+}
+
+func (r *tableResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data tableResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	input := &s3tables.CreateTableInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	_, err := conn.CreateTable(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Tables Table (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	data.setID()
+
+	if !data.MaintenanceConfiguration.IsUnknown() && !data.MaintenanceConfiguration.IsNull() {
+		response.Diagnostics.Append(putTableMaintenanceConfiguration(ctx, conn, &data)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	output, err := findTableByThreePartKey(ctx, conn, data.TableBucketARN.ValueString(), data.Namespace.ValueString(), data.Name.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Tables Table (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(r.flatten(ctx, conn, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *tableResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data tableResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	if err := data.InitFromID(); err != nil {
+		response.Diagnostics.AddError("parsing resource ID", err.Error())
+
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	output, err := findTableByThreePartKey(ctx, conn, data.TableBucketARN.ValueString(), data.Namespace.ValueString(), data.Name.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Tables Table (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(r.flatten(ctx, conn, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *tableResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new tableResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	if !new.MaintenanceConfiguration.Equal(old.MaintenanceConfiguration) {
+		response.Diagnostics.Append(putTableMaintenanceConfiguration(ctx, conn, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		output, err := findTableByThreePartKey(ctx, conn, new.TableBucketARN.ValueString(), new.Namespace.ValueString(), new.Name.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading S3 Tables Table (%s)", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		response.Diagnostics.Append(r.flatten(ctx, conn, output, &new)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *tableResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data tableResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	_, err := conn.DeleteTable(ctx, &s3tables.DeleteTableInput{
+		Name:           fwflex.StringFromFramework(ctx, data.Name),
+		Namespace:      fwflex.StringFromFramework(ctx, data.Namespace),
+		TableBucketARN: fwflex.StringFromFramework(ctx, data.TableBucketARN),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Tables Table (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+// flatten sets the table's attributes from the API and reads its maintenance configuration.
+func (r *tableResource) flatten(ctx context.Context, conn *s3tables.Client, output *s3tables.GetTableOutput, data *tableResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	diags.Append(fwflex.Flatten(ctx, output, data)...)
+	if diags.HasError() {
+		return diags
+	}
+
+	maintenance, err := findTableMaintenanceConfigurationByThreePartKey(ctx, conn, data.TableBucketARN.ValueString(), data.Namespace.ValueString(), data.Name.ValueString())
+
+	if err != nil {
+		diags.AddError(fmt.Sprintf("reading S3 Tables Table (%s) maintenance configuration", data.ID.ValueString()), err.Error())
+
+		return diags
+	}
+
+	var d diag.Diagnostics
+	data.MaintenanceConfiguration, d = flattenTableMaintenanceConfiguration(ctx, maintenance)
+	diags.Append(d...)
+
+	return diags
+}
+
+func findTableByThreePartKey(ctx context.Context, conn *s3tables.Client, tableBucketARN, namespace, name string) (*s3tables.GetTableOutput, error) {
+	input := &s3tables.GetTableInput{
+		Name:           aws.String(name),
+		Namespace:      aws.String(namespace),
+		TableBucketARN: aws.String(tableBucketARN),
+	}
+
+	output, err := conn.GetTable(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findTableMaintenanceConfigurationByThreePartKey(ctx context.Context, conn *s3tables.Client, tableBucketARN, namespace, name string) (*s3tables.GetTableMaintenanceConfigurationOutput, error) {
+	input := &s3tables.GetTableMaintenanceConfigurationInput{
+		Name:           aws.String(name),
+		Namespace:      aws.String(namespace),
+		TableBucketARN: aws.String(tableBucketARN),
+	}
+
+	output, err := conn.GetTableMaintenanceConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func putTableMaintenanceConfiguration(ctx context.Context, conn *s3tables.Client, data *tableResourceModel) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	config, d := data.MaintenanceConfiguration.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	values := make(map[awstypes.TableMaintenanceType]*awstypes.TableMaintenanceConfigurationValue)
+
+	if v := config.IcebergCompaction; !v.IsUnknown() && !v.IsNull() {
+		value, d := expandIcebergCompaction(ctx, v)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		values[awstypes.TableMaintenanceTypeIcebergCompaction] = value
+	}
+
+	if v := config.IcebergSnapshotManagement; !v.IsUnknown() && !v.IsNull() {
+		value, d := expandIcebergSnapshotManagement(ctx, v)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		values[awstypes.TableMaintenanceTypeIcebergSnapshotManagement] = value
+	}
+
+	for typ, value := range values {
+		input := &s3tables.PutTableMaintenanceConfigurationInput{
+			Name:           fwflex.StringFromFramework(ctx, data.Name),
+			Namespace:      fwflex.StringFromFramework(ctx, data.Namespace),
+			TableBucketARN: fwflex.StringFromFramework(ctx, data.TableBucketARN),
+			Type:           typ,
+			Value:          value,
+		}
+
+		_, err := conn.PutTableMaintenanceConfiguration(ctx, input)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("putting S3 Tables Table (%s) maintenance configuration (%s)", data.ID.ValueString(), typ), err.Error())
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+var tableNameValidator = []validator.String{
+	stringvalidator.LengthBetween(1, 255),
+	stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9a-z][0-9a-z_]*[0-9a-z]$|^[0-9a-z]$`), "must contain only lowercase letters, numbers and underscores, and begin and end with a letter or number"),
+}
+
+// The table's namespace is returned by the API as a list, which AutoFlex does not flatten into a string.
+// It is part of the resource ID and is never flattened.
+type tableResourceModel struct {
+	CreatedAt                timetypes.RFC3339                                         `tfsdk:"created_at"`
+	CreatedBy                types.String                                              `tfsdk:"created_by"`
+	Format                   fwtypes.StringEnum[awstypes.OpenTableFormat]              `tfsdk:"format"`
+	ID                       types.String                                              `tfsdk:"id"`
+	MaintenanceConfiguration fwtypes.ObjectValueOf[tableMaintenanceConfigurationModel] `tfsdk:"maintenance_configuration"`
+	ManagedByService         types.String                                              `tfsdk:"managed_by_service"`
+	MetadataLocation         types.String                                              `tfsdk:"metadata_location"`
+	ModifiedAt               timetypes.RFC3339                                         `tfsdk:"modified_at"`
+	ModifiedBy               types.String                                              `tfsdk:"modified_by"`
+	Name                     types.String                                              `tfsdk:"name"`
+	Namespace                types.String                                              `tfsdk:"namespace"`
+	OwnerAccountID           types.String                                              `tfsdk:"owner_account_id"`
+	TableARN                 types.String                                              `tfsdk:"arn"`
+	TableBucketARN           fwtypes.ARN                                               `tfsdk:"table_bucket_arn"`
+	Type                     fwtypes.StringEnum[awstypes.TableType]                    `tfsdk:"type"`
+	VersionToken             types.String                                              `tfsdk:"version_token"`
+	WarehouseLocation        types.String                                              `tfsdk:"warehouse_location"`
+}
+
+const (
+	tableResourceIDPartCount = 3
+)
+
+func (data *tableResourceModel) InitFromID() error {
+	parts, err := flex.ExpandResourceId(data.ID.ValueString(), tableResourceIDPartCount, false)
+
+	if err != nil {
+		return err
+	}
+
+	data.TableBucketARN = fwtypes.ARNValue(parts[0])
+	data.Namespace = types.StringValue(parts[1])
+	data.Name = types.StringValue(parts[2])
+
+	return nil
+}
+
+func (data *tableResourceModel) setID() {
+	data.ID = types.StringValue(errs.Must(flex.FlattenResourceId([]string{data.TableBucketARN.ValueString(), data.Namespace.ValueString(), data.Name.ValueString()}, tableResourceIDPartCount, false)))
+}
+
+// Table maintenance settings are Smithy union types, which AutoFlex does not support.
+type tableMaintenanceConfigurationModel struct {
+	IcebergCompaction         fwtypes.ObjectValueOf[icebergCompactionModel]         `tfsdk:"iceberg_compaction"`
+	IcebergSnapshotManagement fwtypes.ObjectValueOf[icebergSnapshotManagementModel] `tfsdk:"iceberg_snapshot_management"`
+}
+
+type icebergCompactionModel struct {
+	Settings fwtypes.ObjectValueOf[icebergCompactionSettingsModel] `tfsdk:"settings"`
+	Status   fwtypes.StringEnum[awstypes.MaintenanceStatus]        `tfsdk:"status"`
+}
+
+type icebergCompactionSettingsModel struct {
+	TargetFileSizeMB types.Int64 `tfsdk:"target_file_size_mb"`
+}
+
+type icebergSnapshotManagementModel struct {
+	Settings fwtypes.ObjectValueOf[icebergSnapshotManagementSettingsModel] `tfsdk:"settings"`
+	Status   fwtypes.StringEnum[awstypes.MaintenanceStatus]                `tfsdk:"status"`
+}
+
+type icebergSnapshotManagementSettingsModel struct {
+	MaxSnapshotAgeHours types.Int64 `tfsdk:"max_snapshot_age_hours"`
+	MinSnapshotsToKeep  types.Int64 `tfsdk:"min_snapshots_to_keep"`
+}
+
+func expandIcebergCompaction(ctx context.Context, v fwtypes.ObjectValueOf[icebergCompactionModel]) (*awstypes.TableMaintenanceConfigurationValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := v.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	settings, d := data.Settings.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var apiObject awstypes.IcebergCompactionSettings
+	diags.Append(fwflex.Expand(ctx, settings, &apiObject)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &awstypes.TableMaintenanceConfigurationValue{
+		Settings: &awstypes.TableMaintenanceSettingsMemberIcebergCompaction{
+			Value: apiObject,
+		},
+		Status: data.Status.ValueEnum(),
+	}, diags
+}
+
+func expandIcebergSnapshotManagement(ctx context.Context, v fwtypes.ObjectValueOf[icebergSnapshotManagementModel]) (*awstypes.TableMaintenanceConfigurationValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := v.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	settings, d := data.Settings.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var apiObject awstypes.IcebergSnapshotManagementSettings
+	diags.Append(fwflex.Expand(ctx, settings, &apiObject)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &awstypes.TableMaintenanceConfigurationValue{
+		Settings: &awstypes.TableMaintenanceSettingsMemberIcebergSnapshotManagement{
+			Value: apiObject,
+		},
+		Status: data.Status.ValueEnum(),
+	}, diags
+}
+
+func flattenTableMaintenanceConfiguration(ctx context.Context, output *s3tables.GetTableMaintenanceConfigurationOutput) (fwtypes.ObjectValueOf[tableMaintenanceConfigurationModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data := tableMaintenanceConfigurationModel{
+		IcebergCompaction:         fwtypes.NewObjectValueOfNull[icebergCompactionModel](ctx),
+		IcebergSnapshotManagement: fwtypes.NewObjectValueOfNull[icebergSnapshotManagementModel](ctx),
+	}
+
+	if v, ok := output.Configuration[string(awstypes.TableMaintenanceTypeIcebergCompaction)]; ok {
+		if s, ok := v.Settings.(*awstypes.TableMaintenanceSettingsMemberIcebergCompaction); ok {
+			var settings icebergCompactionSettingsModel
+			diags.Append(fwflex.Flatten(ctx, s.Value, &settings)...)
+			if diags.HasError() {
+				return fwtypes.NewObjectValueOfNull[tableMaintenanceConfigurationModel](ctx), diags
+			}
+
+			data.IcebergCompaction = fwtypes.NewObjectValueOfMust(ctx, &icebergCompactionModel{
+				Settings: fwtypes.NewObjectValueOfMust(ctx, &settings),
+				Status:   fwtypes.StringEnumValue(v.Status),
+			})
+		}
+	}
+
+	if v, ok := output.Configuration[string(awstypes.TableMaintenanceTypeIcebergSnapshotManagement)]; ok {
+		if s, ok := v.Settings.(*awstypes.TableMaintenanceSettingsMemberIcebergSnapshotManagement); ok {
+			var settings icebergSnapshotManagementSettingsModel
+			diags.Append(fwflex.Flatten(ctx, s.Value, &settings)...)
+			if diags.HasError() {
+				return fwtypes.NewObjectValueOfNull[tableMaintenanceConfigurationModel](ctx), diags
+			}
+
+			data.IcebergSnapshotManagement = fwtypes.NewObjectValueOfMust(ctx, &icebergSnapshotManagementModel{
+				Settings: fwtypes.NewObjectValueOfMust(ctx, &settings),
+				Status:   fwtypes.StringEnumValue(v.Status),
+			})
+		}
+	}
+
+	return fwtypes.NewObjectValueOfMust(ctx, &data), diags
+}
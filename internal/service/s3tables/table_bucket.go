@@ -0,0 +1,463 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Table Bucket")
+func newTableBucketResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &tableBucketResource{}
+
+	return r, nil
+}
+
+type tableBucketResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (*tableBucketResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_s3tables_table_bucket"
+}
+
+func (r *tableBucketResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreatedAt: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			"maintenance_configuration": schema.SingleNestedAttribute{
+				CustomType: fwtypes.NewObjectTypeOf[tableBucketMaintenanceConfigurationModel](ctx),
+				Optional:   true,
+				Computed:   true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"iceberg_unreferenced_file_removal": schema.SingleNestedAttribute{
+						CustomType: fwtypes.NewObjectTypeOf[icebergUnreferencedFileRemovalModel](ctx),
+						Optional:   true,
+						Computed:   true,
+						PlanModifiers: []planmodifier.Object{
+							objectplanmodifier.UseStateForUnknown(),
+						},
+						Attributes: map[string]schema.Attribute{
+							"settings": schema.SingleNestedAttribute{
+								CustomType: fwtypes.NewObjectTypeOf[icebergUnreferencedFileRemovalSettingsModel](ctx),
+								Required:   true,
+								Attributes: map[string]schema.Attribute{
+									"non_current_days": schema.Int64Attribute{
+										Required: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+									"unreferenced_days": schema.Int64Attribute{
+										Required: true,
+										Validators: []validator.Int64{
+											int64validator.AtLeast(1),
+										},
+									},
+								},
+							},
+							names.AttrStatus: schema.StringAttribute{
+								CustomType: fwtypes.StringEnumType[awstypes.MaintenanceStatus](),
+								Required:   true,
+							},
+						},
+					},
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(3, 63),
+					stringvalidator.RegexMatches(regexache.MustCompile(`^[0-9a-z][0-9a-z-]*[0-9a-z]$`), "must contain only lowercase letters, numbers and hyphens, and begin and end with a letter or number"),
+				},
+			},
+			"owner_account_id": schema.StringAttribute{
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+	}
+}
+
+func (r *tableBucketResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data tableBucketResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	input := &s3tables.CreateTableBucketInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	output, err := conn.CreateTableBucket(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating S3 Tables Table Bucket (%s)", data.Name.ValueString()), err.Error())
+
+		return
+	}
+
+	data.ARN = fwflex.StringToFramework(ctx, output.Arn)
+	data.setID()
+
+	if !data.MaintenanceConfiguration.IsUnknown() && !data.MaintenanceConfiguration.IsNull() {
+		response.Diagnostics.Append(putTableBucketMaintenanceConfiguration(ctx, conn, data.ID.ValueString(), data.MaintenanceConfiguration)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	bucket, err := findTableBucketByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Tables Table Bucket (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	// Set values for unknowns.
+	response.Diagnostics.Append(fwflex.Flatten(ctx, bucket, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	maintenance, err := findTableBucketMaintenanceConfigurationByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Tables Table Bucket (%s) maintenance configuration", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.MaintenanceConfiguration, diags = flattenTableBucketMaintenanceConfiguration(ctx, maintenance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *tableBucketResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data tableBucketResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	output, err := findTableBucketByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Tables Table Bucket (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	maintenance, err := findTableBucketMaintenanceConfigurationByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading S3 Tables Table Bucket (%s) maintenance configuration", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	var diags diag.Diagnostics
+	data.MaintenanceConfiguration, diags = flattenTableBucketMaintenanceConfiguration(ctx, maintenance)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *tableBucketResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new tableBucketResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	if !new.MaintenanceConfiguration.Equal(old.MaintenanceConfiguration) {
+		response.Diagnostics.Append(putTableBucketMaintenanceConfiguration(ctx, conn, new.ID.ValueString(), new.MaintenanceConfiguration)...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+
+		maintenance, err := findTableBucketMaintenanceConfigurationByARN(ctx, conn, new.ID.ValueString())
+
+		if err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("reading S3 Tables Table Bucket (%s) maintenance configuration", new.ID.ValueString()), err.Error())
+
+			return
+		}
+
+		var diags diag.Diagnostics
+		new.MaintenanceConfiguration, diags = flattenTableBucketMaintenanceConfiguration(ctx, maintenance)
+		response.Diagnostics.Append(diags...)
+		if response.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *tableBucketResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data tableBucketResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().S3TablesClient(ctx)
+
+	_, err := conn.DeleteTableBucket(ctx, &s3tables.DeleteTableBucketInput{
+		TableBucketARN: aws.String(data.ID.ValueString()),
+	})
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting S3 Tables Table Bucket (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func findTableBucketByARN(ctx context.Context, conn *s3tables.Client, arn string) (*s3tables.GetTableBucketOutput, error) {
+	input := &s3tables.GetTableBucketInput{
+		TableBucketARN: aws.String(arn),
+	}
+
+	output, err := conn.GetTableBucket(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func findTableBucketMaintenanceConfigurationByARN(ctx context.Context, conn *s3tables.Client, arn string) (*s3tables.GetTableBucketMaintenanceConfigurationOutput, error) {
+	input := &s3tables.GetTableBucketMaintenanceConfigurationInput{
+		TableBucketARN: aws.String(arn),
+	}
+
+	output, err := conn.GetTableBucketMaintenanceConfiguration(ctx, input)
+
+	if errs.IsA[*awstypes.NotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func putTableBucketMaintenanceConfiguration(ctx context.Context, conn *s3tables.Client, arn string, v fwtypes.ObjectValueOf[tableBucketMaintenanceConfigurationModel]) diag.Diagnostics {
+	var diags diag.Diagnostics
+
+	data, d := v.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return diags
+	}
+
+	if v := data.IcebergUnreferencedFileRemoval; !v.IsUnknown() && !v.IsNull() {
+		value, d := expandIcebergUnreferencedFileRemoval(ctx, v)
+		diags.Append(d...)
+		if diags.HasError() {
+			return diags
+		}
+
+		input := &s3tables.PutTableBucketMaintenanceConfigurationInput{
+			TableBucketARN: aws.String(arn),
+			Type:           awstypes.TableBucketMaintenanceTypeIcebergUnreferencedFileRemoval,
+			Value:          value,
+		}
+
+		_, err := conn.PutTableBucketMaintenanceConfiguration(ctx, input)
+
+		if err != nil {
+			diags.AddError(fmt.Sprintf("putting S3 Tables Table Bucket (%s) maintenance configuration (%s)", arn, input.Type), err.Error())
+
+			return diags
+		}
+	}
+
+	return diags
+}
+
+type tableBucketResourceModel struct {
+	ARN                      types.String                                                    `tfsdk:"arn"`
+	CreatedAt                timetypes.RFC3339                                               `tfsdk:"created_at"`
+	ID                       types.String                                                    `tfsdk:"id"`
+	MaintenanceConfiguration fwtypes.ObjectValueOf[tableBucketMaintenanceConfigurationModel] `tfsdk:"maintenance_configuration"`
+	Name                     types.String                                                    `tfsdk:"name"`
+	OwnerAccountID           types.String                                                    `tfsdk:"owner_account_id"`
+}
+
+func (data *tableBucketResourceModel) setID() {
+	data.ID = data.ARN
+}
+
+// Table bucket maintenance settings are Smithy union types, which AutoFlex does not support.
+type tableBucketMaintenanceConfigurationModel struct {
+	IcebergUnreferencedFileRemoval fwtypes.ObjectValueOf[icebergUnreferencedFileRemovalModel] `tfsdk:"iceberg_unreferenced_file_removal"`
+}
+
+type icebergUnreferencedFileRemovalModel struct {
+	Settings fwtypes.ObjectValueOf[icebergUnreferencedFileRemovalSettingsModel] `tfsdk:"settings"`
+	Status   fwtypes.StringEnum[awstypes.MaintenanceStatus]                     `tfsdk:"status"`
+}
+
+type icebergUnreferencedFileRemovalSettingsModel struct {
+	NonCurrentDays   types.Int64 `tfsdk:"non_current_days"`
+	UnreferencedDays types.Int64 `tfsdk:"unreferenced_days"`
+}
+
+func expandIcebergUnreferencedFileRemoval(ctx context.Context, v fwtypes.ObjectValueOf[icebergUnreferencedFileRemovalModel]) (*awstypes.TableBucketMaintenanceConfigurationValue, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data, d := v.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	settings, d := data.Settings.ToPtr(ctx)
+	diags.Append(d...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	var apiObject awstypes.IcebergUnreferencedFileRemovalSettings
+	diags.Append(fwflex.Expand(ctx, settings, &apiObject)...)
+	if diags.HasError() {
+		return nil, diags
+	}
+
+	return &awstypes.TableBucketMaintenanceConfigurationValue{
+		Settings: &awstypes.TableBucketMaintenanceSettingsMemberIcebergUnreferencedFileRemoval{
+			Value: apiObject,
+		},
+		Status: data.Status.ValueEnum(),
+	}, diags
+}
+
+func flattenTableBucketMaintenanceConfiguration(ctx context.Context, output *s3tables.GetTableBucketMaintenanceConfigurationOutput) (fwtypes.ObjectValueOf[tableBucketMaintenanceConfigurationModel], diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	data := tableBucketMaintenanceConfigurationModel{
+		IcebergUnreferencedFileRemoval: fwtypes.NewObjectValueOfNull[icebergUnreferencedFileRemovalModel](ctx),
+	}
+
+	if v, ok := output.Configuration[string(awstypes.TableBucketMaintenanceTypeIcebergUnreferencedFileRemoval)]; ok {
+		if s, ok := v.Settings.(*awstypes.TableBucketMaintenanceSettingsMemberIcebergUnreferencedFileRemoval); ok {
+			var settings icebergUnreferencedFileRemovalSettingsModel
+			diags.Append(fwflex.Flatten(ctx, s.Value, &settings)...)
+			if diags.HasError() {
+				return fwtypes.NewObjectValueOfNull[tableBucketMaintenanceConfigurationModel](ctx), diags
+			}
+
+			data.IcebergUnreferencedFileRemoval = fwtypes.NewObjectValueOfMust(ctx, &icebergUnreferencedFileRemovalModel{
+				Settings: fwtypes.NewObjectValueOfMust(ctx, &settings),
+				Status:   fwtypes.StringEnumValue(v.Status),
+			})
+		}
+	}
+
+	return fwtypes.NewObjectValueOfMust(ctx, &data), diags
+}
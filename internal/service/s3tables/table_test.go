@@ -0,0 +1,215 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package s3tables_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/s3tables"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/s3tables/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tfs3tables "github.com/hashicorp/terraform-provider-aws/internal/service/s3tables"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccS3TablesTable_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v s3tables.GetTableOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	name := strings.ReplaceAll(rName, "-", "_")
+	resourceName := "aws_s3tables_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3TablesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_basic(rName, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &v),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "s3tables", regexache.MustCompile("bucket/"+rName+"/table/.+")),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreatedAt),
+					resource.TestCheckResourceAttr(resourceName, names.AttrFormat, string(awstypes.OpenTableFormatIceberg)),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_compaction.status", string(awstypes.MaintenanceStatusEnabled)),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_snapshot_management.status", string(awstypes.MaintenanceStatusEnabled)),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, name),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrNamespace, "aws_s3tables_namespace.test", names.AttrNamespace),
+					acctest.CheckResourceAttrAccountID(resourceName, "owner_account_id"),
+					resource.TestCheckResourceAttrPair(resourceName, "table_bucket_arn", "aws_s3tables_table_bucket.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrType, string(awstypes.TableTypeCustomer)),
+					resource.TestCheckResourceAttrSet(resourceName, "version_token"),
+					resource.TestCheckResourceAttrSet(resourceName, "warehouse_location"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccS3TablesTable_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v s3tables.GetTableOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	name := strings.ReplaceAll(rName, "-", "_")
+	resourceName := "aws_s3tables_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3TablesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_basic(rName, name),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &v),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tfs3tables.ResourceTable, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccS3TablesTable_maintenanceConfiguration(t *testing.T) {
+	ctx := acctest.Context(t)
+	var v s3tables.GetTableOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	name := strings.ReplaceAll(rName, "-", "_")
+	resourceName := "aws_s3tables_table.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.S3TablesServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckTableDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccTableConfig_maintenanceConfiguration(rName, name, 64, 24, 2),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_compaction.settings.target_file_size_mb", "64"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_compaction.status", string(awstypes.MaintenanceStatusEnabled)),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_snapshot_management.settings.max_snapshot_age_hours", "24"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_snapshot_management.settings.min_snapshots_to_keep", "2"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_snapshot_management.status", string(awstypes.MaintenanceStatusEnabled)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccTableConfig_maintenanceConfiguration(rName, name, 128, 48, 3),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckTableExists(ctx, resourceName, &v),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_compaction.settings.target_file_size_mb", "128"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_snapshot_management.settings.max_snapshot_age_hours", "48"),
+					resource.TestCheckResourceAttr(resourceName, "maintenance_configuration.iceberg_snapshot_management.settings.min_snapshots_to_keep", "3"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckTableDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3TablesClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_s3tables_table" {
+				continue
+			}
+
+			_, err := tfs3tables.FindTableByThreePartKey(ctx, conn, rs.Primary.Attributes["table_bucket_arn"], rs.Primary.Attributes[names.AttrNamespace], rs.Primary.Attributes[names.AttrName])
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("S3 Tables Table %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckTableExists(ctx context.Context, n string, v *s3tables.GetTableOutput) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).S3TablesClient(ctx)
+
+		output, err := tfs3tables.FindTableByThreePartKey(ctx, conn, rs.Primary.Attributes["table_bucket_arn"], rs.Primary.Attributes[names.AttrNamespace], rs.Primary.Attributes[names.AttrName])
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccTableConfig_basic(rName, name string) string {
+	return acctest.ConfigCompose(testAccNamespaceConfig_basic(rName, name), fmt.Sprintf(`
+resource "aws_s3tables_table" "test" {
+  name             = %[1]q
+  namespace        = aws_s3tables_namespace.test.namespace
+  table_bucket_arn = aws_s3tables_namespace.test.table_bucket_arn
+  format           = "ICEBERG"
+}
+`, name))
+}
+
+func testAccTableConfig_maintenanceConfiguration(rName, name string, targetFileSizeMB, maxSnapshotAgeHours, minSnapshotsToKeep int) string {
+	return acctest.ConfigCompose(testAccNamespaceConfig_basic(rName, name), fmt.Sprintf(`
+resource "aws_s3tables_table" "test" {
+  name             = %[1]q
+  namespace        = aws_s3tables_namespace.test.namespace
+  table_bucket_arn = aws_s3tables_namespace.test.table_bucket_arn
+  format           = "ICEBERG"
+
+  maintenance_configuration = {
+    iceberg_compaction = {
+      settings = {
+        target_file_size_mb = %[2]d
+      }
+      status = "enabled"
+    }
+    iceberg_snapshot_management = {
+      settings = {
+        max_snapshot_age_hours = %[3]d
+        min_snapshots_to_keep  = %[4]d
+      }
+      status = "enabled"
+    }
+  }
+}
+`, name, targetFileSizeMB, maxSnapshotAgeHours, minSnapshotsToKeep))
+}
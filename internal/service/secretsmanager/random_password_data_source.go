@@ -15,6 +15,7 @@ import (
 )
 
 // @SDKDataSource("aws_secretsmanager_random_password", name="Random Password")
+// @NoMemoize
 func dataSourceRandomPassword() *schema.Resource {
 	return &schema.Resource{
 		ReadWithoutTimeout: dataSourceRandomPasswordRead,
@@ -54,6 +54,41 @@ func TestAccSecretsManagerSecretVersion_basicString(t *testing.T) {
 	})
 }
 
+func TestAccSecretsManagerSecretVersion_stringWriteOnly(t *testing.T) {
+	ctx := acctest.Context(t)
+	var version secretsmanager.GetSecretValueOutput
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_secretsmanager_secret_version.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck:                 func() { acctest.PreCheck(ctx, t); testAccPreCheck(ctx, t) },
+		ErrorCheck:               acctest.ErrorCheck(t, names.SecretsManagerServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckSecretVersionDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccSecretVersionConfig_stringWriteOnly(rName, "test-string-1", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretVersionExists(ctx, resourceName, &version),
+					testAccCheckSecretVersionWriteOnlyValueEqual(&version, "test-string-1"),
+					resource.TestCheckNoResourceAttr(resourceName, "secret_string"),
+					resource.TestCheckNoResourceAttr(resourceName, "secret_string_wo"),
+					resource.TestCheckResourceAttr(resourceName, "secret_string_wo_version", acctest.CtOne),
+				),
+			},
+			{
+				Config: testAccSecretVersionConfig_stringWriteOnly(rName, "test-string-2", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckSecretVersionExists(ctx, resourceName, &version),
+					testAccCheckSecretVersionWriteOnlyValueEqual(&version, "test-string-2"),
+					resource.TestCheckNoResourceAttr(resourceName, "secret_string"),
+					resource.TestCheckResourceAttr(resourceName, "secret_string_wo_version", "2"),
+				),
+			},
+		},
+	})
+}
+
 func TestAccSecretsManagerSecretVersion_base64Binary(t *testing.T) {
 	ctx := acctest.Context(t)
 	var version secretsmanager.GetSecretValueOutput
@@ -271,6 +306,16 @@ func TestAccSecretsManagerSecretVersion_multipleVersions(t *testing.T) {
 	})
 }
 
+func testAccCheckSecretVersionWriteOnlyValueEqual(version *secretsmanager.GetSecretValueOutput, want string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if got := aws.ToString(version.SecretString); got != want {
+			return fmt.Errorf("Secrets Manager Secret Version secret string = %q, want %q", got, want)
+		}
+
+		return nil
+	}
+}
+
 func testAccCheckSecretVersionDestroy(ctx context.Context) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		conn := acctest.Provider.Meta().(*conns.AWSClient).SecretsManagerClient(ctx)
@@ -335,6 +380,20 @@ resource "aws_secretsmanager_secret_version" "test" {
 `, rName)
 }
 
+func testAccSecretVersionConfig_stringWriteOnly(rName, secretString string, secretStringVersion int) string {
+	return fmt.Sprintf(`
+resource "aws_secretsmanager_secret" "test" {
+  name = %[1]q
+}
+
+resource "aws_secretsmanager_secret_version" "test" {
+  secret_id                = aws_secretsmanager_secret.test.id
+  secret_string_wo         = %[2]q
+  secret_string_wo_version = %[3]d
+}
+`, rName, secretString, secretStringVersion)
+}
+
 func testAccSecretVersionConfig_binary(rName string) string {
 	return fmt.Sprintf(`
 resource "aws_secretsmanager_secret" "test" {
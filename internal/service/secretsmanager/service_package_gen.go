@@ -25,9 +25,10 @@ func (p *servicePackage) FrameworkResources(ctx context.Context) []*types.Servic
 func (p *servicePackage) SDKDataSources(ctx context.Context) []*types.ServicePackageSDKDataSource {
 	return []*types.ServicePackageSDKDataSource{
 		{
-			Factory:  dataSourceRandomPassword,
-			TypeName: "aws_secretsmanager_random_password",
-			Name:     "Random Password",
+			Factory:   dataSourceRandomPassword,
+			TypeName:  "aws_secretsmanager_random_password",
+			Name:      "Random Password",
+			NoMemoize: true,
 		},
 		{
 			Factory:  dataSourceSecret,
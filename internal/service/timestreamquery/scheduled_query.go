@@ -0,0 +1,602 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestreamquery
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamquery"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/timestreamquery/types"
+	"github.com/hashicorp/terraform-plugin-framework-timetypes/timetypes"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	sdkid "github.com/hashicorp/terraform-plugin-sdk/v2/helper/id"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs/fwdiag"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwflex "github.com/hashicorp/terraform-provider-aws/internal/framework/flex"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	tftags "github.com/hashicorp/terraform-provider-aws/internal/tags"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkResource(name="Scheduled Query")
+// @Tags(identifierAttribute="arn")
+func newScheduledQueryResource(_ context.Context) (resource.ResourceWithConfigure, error) {
+	r := &scheduledQueryResource{}
+
+	return r, nil
+}
+
+type scheduledQueryResource struct {
+	framework.ResourceWithConfigure
+	framework.WithImportByID
+}
+
+func (r *scheduledQueryResource) Metadata(_ context.Context, request resource.MetadataRequest, response *resource.MetadataResponse) {
+	response.TypeName = "aws_timestreamquery_scheduled_query"
+}
+
+func (r *scheduledQueryResource) Schema(ctx context.Context, request resource.SchemaRequest, response *resource.SchemaResponse) {
+	multiMeasureAttributeMappingBlock := schema.ListNestedBlock{
+		CustomType: fwtypes.NewListNestedObjectTypeOf[multiMeasureAttributeMappingModel](ctx),
+		NestedObject: schema.NestedBlockObject{
+			Attributes: map[string]schema.Attribute{
+				"measure_value_type": schema.StringAttribute{
+					CustomType: fwtypes.StringEnumType[awstypes.ScalarMeasureValueType](),
+					Required:   true,
+				},
+				"source_column": schema.StringAttribute{
+					Required: true,
+				},
+				"target_multi_measure_attribute_name": schema.StringAttribute{
+					Optional: true,
+				},
+			},
+		},
+	}
+
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			names.AttrARN: framework.ARNAttributeComputedOnly(),
+			names.AttrCreationTime: schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			names.AttrExecutionRoleARN: schema.StringAttribute{
+				CustomType: fwtypes.ARNType,
+				Required:   true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrKMSKeyID: schema.StringAttribute{
+				Optional: true,
+				Computed: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+					stringplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 2048),
+				},
+			},
+			names.AttrName: schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 64),
+				},
+			},
+			"next_invocation_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"previous_invocation_time": schema.StringAttribute{
+				CustomType: timetypes.RFC3339Type{},
+				Computed:   true,
+			},
+			"query_string": schema.StringAttribute{
+				Required: true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.LengthBetween(1, 262144),
+				},
+			},
+			names.AttrState: schema.StringAttribute{
+				CustomType: fwtypes.StringEnumType[awstypes.ScheduledQueryState](),
+				Optional:   true,
+				Computed:   true,
+			},
+			names.AttrTags:    tftags.TagsAttribute(),
+			names.AttrTagsAll: tftags.TagsAttributeComputedOnly(),
+		},
+		Blocks: map[string]schema.Block{
+			"error_report_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[errorReportConfigurationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"s3_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[s3ConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrBucketName: schema.StringAttribute{
+										Required: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(3, 63),
+										},
+									},
+									"encryption_option": schema.StringAttribute{
+										CustomType: fwtypes.StringEnumType[awstypes.S3EncryptionOption](),
+										Optional:   true,
+										Computed:   true,
+									},
+									"object_key_prefix": schema.StringAttribute{
+										Optional: true,
+										Validators: []validator.String{
+											stringvalidator.LengthBetween(1, 896),
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"notification_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[notificationConfigurationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"sns_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[snsConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrTopicARN: schema.StringAttribute{
+										CustomType: fwtypes.ARNType,
+										Required:   true,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"schedule_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[scheduleConfigurationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.IsRequired(),
+					listvalidator.SizeAtLeast(1),
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Attributes: map[string]schema.Attribute{
+						names.AttrScheduleExpression: schema.StringAttribute{
+							Required: true,
+							Validators: []validator.String{
+								stringvalidator.LengthBetween(1, 256),
+							},
+						},
+					},
+				},
+			},
+			"target_configuration": schema.ListNestedBlock{
+				CustomType: fwtypes.NewListNestedObjectTypeOf[targetConfigurationModel](ctx),
+				PlanModifiers: []planmodifier.List{
+					listplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.List{
+					listvalidator.SizeAtMost(1),
+				},
+				NestedObject: schema.NestedBlockObject{
+					Blocks: map[string]schema.Block{
+						"timestream_configuration": schema.ListNestedBlock{
+							CustomType: fwtypes.NewListNestedObjectTypeOf[timestreamConfigurationModel](ctx),
+							Validators: []validator.List{
+								listvalidator.IsRequired(),
+								listvalidator.SizeAtLeast(1),
+								listvalidator.SizeAtMost(1),
+							},
+							NestedObject: schema.NestedBlockObject{
+								Attributes: map[string]schema.Attribute{
+									names.AttrDatabaseName: schema.StringAttribute{
+										Required: true,
+									},
+									"measure_name_column": schema.StringAttribute{
+										Optional: true,
+									},
+									names.AttrTableName: schema.StringAttribute{
+										Required: true,
+									},
+									"time_column": schema.StringAttribute{
+										Required: true,
+									},
+								},
+								Blocks: map[string]schema.Block{
+									"dimension_mapping": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[dimensionMappingModel](ctx),
+										Validators: []validator.List{
+											listvalidator.IsRequired(),
+											listvalidator.SizeAtLeast(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"dimension_value_type": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.DimensionValueType](),
+													Required:   true,
+												},
+												names.AttrName: schema.StringAttribute{
+													Required: true,
+												},
+											},
+										},
+									},
+									"mixed_measure_mapping": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[mixedMeasureMappingModel](ctx),
+										Validators: []validator.List{
+											listvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("multi_measure_mappings")),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"measure_name": schema.StringAttribute{
+													Optional: true,
+												},
+												"measure_value_type": schema.StringAttribute{
+													CustomType: fwtypes.StringEnumType[awstypes.MeasureValueType](),
+													Required:   true,
+												},
+												"source_column": schema.StringAttribute{
+													Optional: true,
+												},
+												"target_measure_name": schema.StringAttribute{
+													Optional: true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"multi_measure_attribute_mapping": multiMeasureAttributeMappingBlock,
+											},
+										},
+									},
+									"multi_measure_mappings": schema.ListNestedBlock{
+										CustomType: fwtypes.NewListNestedObjectTypeOf[multiMeasureMappingsModel](ctx),
+										Validators: []validator.List{
+											listvalidator.SizeAtMost(1),
+										},
+										NestedObject: schema.NestedBlockObject{
+											Attributes: map[string]schema.Attribute{
+												"target_multi_measure_name": schema.StringAttribute{
+													Optional: true,
+												},
+											},
+											Blocks: map[string]schema.Block{
+												"multi_measure_attribute_mapping": multiMeasureAttributeMappingBlock,
+											},
+										},
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (r *scheduledQueryResource) Create(ctx context.Context, request resource.CreateRequest, response *resource.CreateResponse) {
+	var data scheduledQueryResourceModel
+	response.Diagnostics.Append(request.Plan.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TimestreamQueryClient(ctx)
+
+	name := data.Name.ValueString()
+	input := &timestreamquery.CreateScheduledQueryInput{}
+	response.Diagnostics.Append(fwflex.Expand(ctx, data, input)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	// Additional fields.
+	input.ClientToken = aws.String(sdkid.UniqueId())
+	input.Tags = getTagsIn(ctx)
+
+	output, err := conn.CreateScheduledQuery(ctx, input)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("creating Timestream Query Scheduled Query (%s)", name), err.Error())
+
+		return
+	}
+
+	data.ID = fwflex.StringToFramework(ctx, output.Arn)
+
+	// The query is created in the ENABLED state.
+	if state := data.State.ValueEnum(); state == awstypes.ScheduledQueryStateDisabled {
+		if err := updateScheduledQueryState(ctx, conn, data.ID.ValueString(), state); err != nil {
+			response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+			response.Diagnostics.AddError(fmt.Sprintf("updating Timestream Query Scheduled Query (%s) state", name), err.Error())
+
+			return
+		}
+	}
+
+	scheduledQuery, err := findScheduledQueryByARN(ctx, conn, data.ID.ValueString())
+
+	if err != nil {
+		response.State.SetAttribute(ctx, path.Root(names.AttrID), data.ID) // Set 'id' so as to taint the resource.
+		response.Diagnostics.AddError(fmt.Sprintf("reading Timestream Query Scheduled Query (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, scheduledQuery, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *scheduledQueryResource) Read(ctx context.Context, request resource.ReadRequest, response *resource.ReadResponse) {
+	var data scheduledQueryResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TimestreamQueryClient(ctx)
+
+	output, err := findScheduledQueryByARN(ctx, conn, data.ID.ValueString())
+
+	if tfresource.NotFound(err) {
+		response.Diagnostics.Append(fwdiag.NewResourceNotFoundWarningDiagnostic(err))
+		response.State.RemoveResource(ctx)
+
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Timestream Query Scheduled Query (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+func (r *scheduledQueryResource) Update(ctx context.Context, request resource.UpdateRequest, response *resource.UpdateResponse) {
+	var old, new scheduledQueryResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &old)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+	response.Diagnostics.Append(request.Plan.Get(ctx, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TimestreamQueryClient(ctx)
+
+	if !new.State.IsUnknown() && !new.State.Equal(old.State) {
+		if err := updateScheduledQueryState(ctx, conn, new.ID.ValueString(), new.State.ValueEnum()); err != nil {
+			response.Diagnostics.AddError(fmt.Sprintf("updating Timestream Query Scheduled Query (%s) state", new.ID.ValueString()), err.Error())
+
+			return
+		}
+	}
+
+	output, err := findScheduledQueryByARN(ctx, conn, new.ID.ValueString())
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Timestream Query Scheduled Query (%s)", new.ID.ValueString()), err.Error())
+
+		return
+	}
+
+	response.Diagnostics.Append(fwflex.Flatten(ctx, output, &new)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	response.Diagnostics.Append(response.State.Set(ctx, &new)...)
+}
+
+func (r *scheduledQueryResource) Delete(ctx context.Context, request resource.DeleteRequest, response *resource.DeleteResponse) {
+	var data scheduledQueryResourceModel
+	response.Diagnostics.Append(request.State.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := r.Meta().TimestreamQueryClient(ctx)
+
+	_, err := conn.DeleteScheduledQuery(ctx, &timestreamquery.DeleteScheduledQueryInput{
+		ScheduledQueryArn: fwflex.StringFromFramework(ctx, data.ID),
+	})
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return
+	}
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("deleting Timestream Query Scheduled Query (%s)", data.ID.ValueString()), err.Error())
+
+		return
+	}
+}
+
+func (r *scheduledQueryResource) ModifyPlan(ctx context.Context, request resource.ModifyPlanRequest, response *resource.ModifyPlanResponse) {
+	r.SetTagsAll(ctx, request, response)
+}
+
+func updateScheduledQueryState(ctx context.Context, conn *timestreamquery.Client, arn string, state awstypes.ScheduledQueryState) error {
+	input := &timestreamquery.UpdateScheduledQueryInput{
+		ScheduledQueryArn: aws.String(arn),
+		State:             state,
+	}
+
+	_, err := conn.UpdateScheduledQuery(ctx, input)
+
+	return err
+}
+
+func findScheduledQueryByARN(ctx context.Context, conn *timestreamquery.Client, arn string) (*awstypes.ScheduledQueryDescription, error) {
+	input := &timestreamquery.DescribeScheduledQueryInput{
+		ScheduledQueryArn: aws.String(arn),
+	}
+
+	output, err := conn.DescribeScheduledQuery(ctx, input)
+
+	if errs.IsA[*awstypes.ResourceNotFoundException](err) {
+		return nil, &retry.NotFoundError{
+			LastError:   err,
+			LastRequest: input,
+		}
+	}
+
+	if err != nil {
+		return nil, err
+	}
+
+	if output == nil || output.ScheduledQuery == nil {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output.ScheduledQuery, nil
+}
+
+type scheduledQueryResourceModel struct {
+	ARN                            types.String                                                    `tfsdk:"arn"`
+	CreationTime                   timetypes.RFC3339                                               `tfsdk:"creation_time"`
+	ErrorReportConfiguration       fwtypes.ListNestedObjectValueOf[errorReportConfigurationModel]  `tfsdk:"error_report_configuration"`
+	ID                             types.String                                                    `tfsdk:"id"`
+	KMSKeyID                       types.String                                                    `tfsdk:"kms_key_id"`
+	Name                           types.String                                                    `tfsdk:"name"`
+	NextInvocationTime             timetypes.RFC3339                                               `tfsdk:"next_invocation_time"`
+	NotificationConfiguration      fwtypes.ListNestedObjectValueOf[notificationConfigurationModel] `tfsdk:"notification_configuration"`
+	PreviousInvocationTime         timetypes.RFC3339                                               `tfsdk:"previous_invocation_time"`
+	QueryString                    types.String                                                    `tfsdk:"query_string"`
+	ScheduleConfiguration          fwtypes.ListNestedObjectValueOf[scheduleConfigurationModel]     `tfsdk:"schedule_configuration"`
+	ScheduledQueryExecutionRoleARN fwtypes.ARN                                                     `tfsdk:"execution_role_arn"`
+	State                          fwtypes.StringEnum[awstypes.ScheduledQueryState]                `tfsdk:"state"`
+	Tags                           types.Map                                                       `tfsdk:"tags"`
+	TagsAll                        types.Map                                                       `tfsdk:"tags_all"`
+	TargetConfiguration            fwtypes.ListNestedObjectValueOf[targetConfigurationModel]       `tfsdk:"target_configuration"`
+}
+
+type errorReportConfigurationModel struct {
+	S3Configuration fwtypes.ListNestedObjectValueOf[s3ConfigurationModel] `tfsdk:"s3_configuration"`
+}
+
+type s3ConfigurationModel struct {
+	BucketName       types.String                                    `tfsdk:"bucket_name"`
+	EncryptionOption fwtypes.StringEnum[awstypes.S3EncryptionOption] `tfsdk:"encryption_option"`
+	ObjectKeyPrefix  types.String                                    `tfsdk:"object_key_prefix"`
+}
+
+type notificationConfigurationModel struct {
+	SnsConfiguration fwtypes.ListNestedObjectValueOf[snsConfigurationModel] `tfsdk:"sns_configuration"`
+}
+
+type snsConfigurationModel struct {
+	TopicARN fwtypes.ARN `tfsdk:"topic_arn"`
+}
+
+type scheduleConfigurationModel struct {
+	ScheduleExpression types.String `tfsdk:"schedule_expression"`
+}
+
+type targetConfigurationModel struct {
+	TimestreamConfiguration fwtypes.ListNestedObjectValueOf[timestreamConfigurationModel] `tfsdk:"timestream_configuration"`
+}
+
+type timestreamConfigurationModel struct {
+	DatabaseName         types.String                                               `tfsdk:"database_name"`
+	DimensionMappings    fwtypes.ListNestedObjectValueOf[dimensionMappingModel]     `tfsdk:"dimension_mapping"`
+	MeasureNameColumn    types.String                                               `tfsdk:"measure_name_column"`
+	MixedMeasureMappings fwtypes.ListNestedObjectValueOf[mixedMeasureMappingModel]  `tfsdk:"mixed_measure_mapping"`
+	MultiMeasureMappings fwtypes.ListNestedObjectValueOf[multiMeasureMappingsModel] `tfsdk:"multi_measure_mappings"`
+	TableName            types.String                                               `tfsdk:"table_name"`
+	TimeColumn           types.String                                               `tfsdk:"time_column"`
+}
+
+type dimensionMappingModel struct {
+	DimensionValueType fwtypes.StringEnum[awstypes.DimensionValueType] `tfsdk:"dimension_value_type"`
+	Name               types.String                                    `tfsdk:"name"`
+}
+
+type mixedMeasureMappingModel struct {
+	MeasureName                   types.String                                                       `tfsdk:"measure_name"`
+	MeasureValueType              fwtypes.StringEnum[awstypes.MeasureValueType]                      `tfsdk:"measure_value_type"`
+	MultiMeasureAttributeMappings fwtypes.ListNestedObjectValueOf[multiMeasureAttributeMappingModel] `tfsdk:"multi_measure_attribute_mapping"`
+	SourceColumn                  types.String                                                       `tfsdk:"source_column"`
+	TargetMeasureName             types.String                                                       `tfsdk:"target_measure_name"`
+}
+
+type multiMeasureMappingsModel struct {
+	MultiMeasureAttributeMappings fwtypes.ListNestedObjectValueOf[multiMeasureAttributeMappingModel] `tfsdk:"multi_measure_attribute_mapping"`
+	TargetMultiMeasureName        types.String                                                       `tfsdk:"target_multi_measure_name"`
+}
+
+type multiMeasureAttributeMappingModel struct {
+	MeasureValueType                fwtypes.StringEnum[awstypes.ScalarMeasureValueType] `tfsdk:"measure_value_type"`
+	SourceColumn                    types.String                                        `tfsdk:"source_column"`
+	TargetMultiMeasureAttributeName types.String                                        `tfsdk:"target_multi_measure_attribute_name"`
+}
@@ -0,0 +1,427 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestreamquery_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamquery"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/timestreamquery/types"
+	sdkacctest "github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-provider-aws/internal/acctest"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	tftimestreamquery "github.com/hashicorp/terraform-provider-aws/internal/service/timestreamquery"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func TestAccTimestreamQueryScheduledQuery_basic(t *testing.T) {
+	ctx := acctest.Context(t)
+	var scheduledQuery awstypes.ScheduledQueryDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_timestreamquery_scheduled_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TimestreamQueryServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledQueryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledQueryConfig_basic(rName),
+				Check: resource.ComposeAggregateTestCheckFunc(
+					testAccCheckScheduledQueryExists(ctx, resourceName, &scheduledQuery),
+					acctest.MatchResourceAttrRegionalARN(resourceName, names.AttrARN, "timestream", regexache.MustCompile(`scheduled-query/.+$`)),
+					resource.TestCheckResourceAttrSet(resourceName, names.AttrCreationTime),
+					resource.TestCheckResourceAttr(resourceName, "error_report_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "error_report_configuration.0.s3_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(resourceName, "error_report_configuration.0.s3_configuration.0.bucket_name", "aws_s3_bucket.test", names.AttrBucket),
+					resource.TestCheckResourceAttrPair(resourceName, names.AttrExecutionRoleARN, "aws_iam_role.test", names.AttrARN),
+					resource.TestCheckResourceAttr(resourceName, names.AttrName, rName),
+					resource.TestCheckResourceAttr(resourceName, "notification_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(resourceName, "notification_configuration.0.sns_configuration.0.topic_arn", "aws_sns_topic.test", names.AttrARN),
+					resource.TestCheckResourceAttrSet(resourceName, "query_string"),
+					resource.TestCheckResourceAttr(resourceName, "schedule_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "schedule_configuration.0.schedule_expression", "rate(1 hour)"),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(awstypes.ScheduledQueryStateEnabled)),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "0"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.#", acctest.CtOne),
+					resource.TestCheckResourceAttrPair(resourceName, "target_configuration.0.timestream_configuration.0.database_name", "aws_timestreamwrite_database.results", names.AttrDatabaseName),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.dimension_mapping.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.dimension_mapping.0.dimension_value_type", string(awstypes.DimensionValueTypeVarchar)),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.dimension_mapping.0.name", "region"),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.multi_measure_mappings.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.multi_measure_mappings.0.multi_measure_attribute_mapping.#", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "target_configuration.0.timestream_configuration.0.time_column", "binned_timestamp"),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"next_invocation_time",
+					"previous_invocation_time",
+				},
+			},
+		},
+	})
+}
+
+func TestAccTimestreamQueryScheduledQuery_disappears(t *testing.T) {
+	ctx := acctest.Context(t)
+	var scheduledQuery awstypes.ScheduledQueryDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_timestreamquery_scheduled_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TimestreamQueryServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledQueryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledQueryConfig_basic(rName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledQueryExists(ctx, resourceName, &scheduledQuery),
+					acctest.CheckFrameworkResourceDisappears(ctx, acctest.Provider, tftimestreamquery.ResourceScheduledQuery, resourceName),
+				),
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func TestAccTimestreamQueryScheduledQuery_state(t *testing.T) {
+	ctx := acctest.Context(t)
+	var scheduledQuery awstypes.ScheduledQueryDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_timestreamquery_scheduled_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TimestreamQueryServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledQueryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledQueryConfig_state(rName, string(awstypes.ScheduledQueryStateDisabled)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledQueryExists(ctx, resourceName, &scheduledQuery),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(awstypes.ScheduledQueryStateDisabled)),
+				),
+			},
+			{
+				ResourceName:      resourceName,
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"next_invocation_time",
+					"previous_invocation_time",
+				},
+			},
+			{
+				Config: testAccScheduledQueryConfig_state(rName, string(awstypes.ScheduledQueryStateEnabled)),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledQueryExists(ctx, resourceName, &scheduledQuery),
+					resource.TestCheckResourceAttr(resourceName, names.AttrState, string(awstypes.ScheduledQueryStateEnabled)),
+				),
+			},
+		},
+	})
+}
+
+func TestAccTimestreamQueryScheduledQuery_tags(t *testing.T) {
+	ctx := acctest.Context(t)
+	var scheduledQuery awstypes.ScheduledQueryDescription
+	rName := sdkacctest.RandomWithPrefix(acctest.ResourcePrefix)
+	resourceName := "aws_timestreamquery_scheduled_query.test"
+
+	resource.ParallelTest(t, resource.TestCase{
+		PreCheck: func() {
+			acctest.PreCheck(ctx, t)
+			testAccPreCheck(ctx, t)
+		},
+		ErrorCheck:               acctest.ErrorCheck(t, names.TimestreamQueryServiceID),
+		ProtoV5ProviderFactories: acctest.ProtoV5ProviderFactories,
+		CheckDestroy:             testAccCheckScheduledQueryDestroy(ctx),
+		Steps: []resource.TestStep{
+			{
+				Config: testAccScheduledQueryConfig_tags1(rName, "key1", "value1"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledQueryExists(ctx, resourceName, &scheduledQuery),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1"),
+				),
+			},
+			{
+				Config: testAccScheduledQueryConfig_tags2(rName, "key1", "value1updated", "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledQueryExists(ctx, resourceName, &scheduledQuery),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", "2"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key1", "value1updated"),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+			{
+				Config: testAccScheduledQueryConfig_tags1(rName, "key2", "value2"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckScheduledQueryExists(ctx, resourceName, &scheduledQuery),
+					resource.TestCheckResourceAttr(resourceName, "tags.%", acctest.CtOne),
+					resource.TestCheckResourceAttr(resourceName, "tags.key2", "value2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckScheduledQueryDestroy(ctx context.Context) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TimestreamQueryClient(ctx)
+
+		for _, rs := range s.RootModule().Resources {
+			if rs.Type != "aws_timestreamquery_scheduled_query" {
+				continue
+			}
+
+			_, err := tftimestreamquery.FindScheduledQueryByARN(ctx, conn, rs.Primary.ID)
+
+			if tfresource.NotFound(err) {
+				continue
+			}
+
+			if err != nil {
+				return err
+			}
+
+			return fmt.Errorf("Timestream Query Scheduled Query %s still exists", rs.Primary.ID)
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckScheduledQueryExists(ctx context.Context, n string, v *awstypes.ScheduledQueryDescription) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		conn := acctest.Provider.Meta().(*conns.AWSClient).TimestreamQueryClient(ctx)
+
+		output, err := tftimestreamquery.FindScheduledQueryByARN(ctx, conn, rs.Primary.ID)
+
+		if err != nil {
+			return err
+		}
+
+		*v = *output
+
+		return nil
+	}
+}
+
+func testAccPreCheck(ctx context.Context, t *testing.T) {
+	conn := acctest.Provider.Meta().(*conns.AWSClient).TimestreamQueryClient(ctx)
+
+	input := &timestreamquery.ListScheduledQueriesInput{}
+	_, err := conn.ListScheduledQueries(ctx, input)
+
+	if acctest.PreCheckSkipError(err) {
+		t.Skipf("skipping acceptance testing: %s", err)
+	}
+	if err != nil {
+		t.Fatalf("unexpected PreCheck error: %s", err)
+	}
+}
+
+func testAccScheduledQueryConfig_base(rName string) string {
+	return fmt.Sprintf(`
+resource "aws_s3_bucket" "test" {
+  bucket        = %[1]q
+  force_destroy = true
+}
+
+resource "aws_sns_topic" "test" {
+  name = %[1]q
+}
+
+resource "aws_timestreamwrite_database" "test" {
+  database_name = "%[1]s-source"
+}
+
+resource "aws_timestreamwrite_table" "test" {
+  database_name = aws_timestreamwrite_database.test.database_name
+  table_name    = %[1]q
+
+  magnetic_store_write_properties {
+    enable_magnetic_store_writes = true
+  }
+
+  retention_properties {
+    magnetic_store_retention_period_in_days = 1
+    memory_store_retention_period_in_hours  = 1
+  }
+}
+
+resource "aws_timestreamwrite_database" "results" {
+  database_name = "%[1]s-results"
+}
+
+resource "aws_timestreamwrite_table" "results" {
+  database_name = aws_timestreamwrite_database.results.database_name
+  table_name    = "%[1]s-results"
+
+  magnetic_store_write_properties {
+    enable_magnetic_store_writes = true
+  }
+
+  retention_properties {
+    magnetic_store_retention_period_in_days = 1
+    memory_store_retention_period_in_hours  = 1
+  }
+}
+
+data "aws_partition" "current" {}
+
+resource "aws_iam_role" "test" {
+  name = %[1]q
+
+  assume_role_policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = "sts:AssumeRole"
+      Effect = "Allow"
+      Principal = {
+        Service = "timestream.${data.aws_partition.current.dns_suffix}"
+      }
+    }]
+  })
+}
+
+resource "aws_iam_role_policy" "test" {
+  name = %[1]q
+  role = aws_iam_role.test.id
+
+  policy = jsonencode({
+    Version = "2012-10-17"
+    Statement = [{
+      Action = [
+        "kms:Decrypt",
+        "sns:Publish",
+        "timestream:describeEndpoints",
+        "timestream:Select",
+        "timestream:SelectValues",
+        "timestream:WriteRecords",
+        "s3:GetObject",
+        "s3:List*",
+        "s3:Put*",
+      ]
+      Effect   = "Allow"
+      Resource = "*"
+    }]
+  })
+}
+`, rName)
+}
+
+func testAccScheduledQueryConfig_resource(rName, extra string) string {
+	return acctest.ConfigCompose(testAccScheduledQueryConfig_base(rName), fmt.Sprintf(`
+resource "aws_timestreamquery_scheduled_query" "test" {
+  name               = %[1]q
+  execution_role_arn = aws_iam_role.test.arn
+  query_string       = <<EOQ
+SELECT region, bin(time, 1h) AS binned_timestamp, avg(measure_value::double) AS avg_cpu_utilization
+FROM "${aws_timestreamwrite_database.test.database_name}"."${aws_timestreamwrite_table.test.table_name}"
+WHERE measure_name = 'cpu_utilization' AND time > ago(2h)
+GROUP BY region, bin(time, 1h)
+EOQ
+
+  error_report_configuration {
+    s3_configuration {
+      bucket_name = aws_s3_bucket.test.bucket
+    }
+  }
+
+  notification_configuration {
+    sns_configuration {
+      topic_arn = aws_sns_topic.test.arn
+    }
+  }
+
+  schedule_configuration {
+    schedule_expression = "rate(1 hour)"
+  }
+
+  target_configuration {
+    timestream_configuration {
+      database_name = aws_timestreamwrite_database.results.database_name
+      table_name    = aws_timestreamwrite_table.results.table_name
+      time_column   = "binned_timestamp"
+
+      dimension_mapping {
+        name                 = "region"
+        dimension_value_type = "VARCHAR"
+      }
+
+      multi_measure_mappings {
+        target_multi_measure_name = "multi-metrics"
+
+        multi_measure_attribute_mapping {
+          source_column      = "avg_cpu_utilization"
+          measure_value_type = "DOUBLE"
+        }
+      }
+    }
+  }
+
+%[2]s
+
+  depends_on = [aws_iam_role_policy.test]
+}
+`, rName, extra))
+}
+
+func testAccScheduledQueryConfig_basic(rName string) string {
+	return testAccScheduledQueryConfig_resource(rName, "")
+}
+
+func testAccScheduledQueryConfig_state(rName, state string) string {
+	return testAccScheduledQueryConfig_resource(rName, fmt.Sprintf(`
+  state = %[1]q
+`, state))
+}
+
+func testAccScheduledQueryConfig_tags1(rName, tagKey1, tagValue1 string) string {
+	return testAccScheduledQueryConfig_resource(rName, fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+  }
+`, tagKey1, tagValue1))
+}
+
+func testAccScheduledQueryConfig_tags2(rName, tagKey1, tagValue1, tagKey2, tagValue2 string) string {
+	return testAccScheduledQueryConfig_resource(rName, fmt.Sprintf(`
+  tags = {
+    %[1]q = %[2]q
+    %[3]q = %[4]q
+  }
+`, tagKey1, tagValue1, tagKey2, tagValue2))
+}
@@ -0,0 +1,50 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestreamquery
+
+import (
+	"context"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamquery"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/hashicorp/terraform-provider-aws/internal/conns"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/awsv2"
+	"github.com/hashicorp/terraform-provider-aws/internal/sweep/framework"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+func RegisterSweepers() {
+	sweep.Register("aws_timestreamquery_scheduled_query", sweepScheduledQueries)
+}
+
+func sweepScheduledQueries(ctx context.Context, client *conns.AWSClient) ([]sweep.Sweepable, error) {
+	conn := client.TimestreamQueryClient(ctx)
+
+	var sweepResources []sweep.Sweepable
+
+	pages := timestreamquery.NewListScheduledQueriesPaginator(conn, &timestreamquery.ListScheduledQueriesInput{})
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if awsv2.SkipSweepError(err) {
+			tflog.Warn(ctx, "Skipping sweeper", map[string]any{
+				"error": err.Error(),
+			})
+			return nil, nil
+		}
+		if err != nil {
+			return nil, err
+		}
+
+		for _, v := range page.ScheduledQueries {
+			sweepResources = append(sweepResources, framework.NewSweepResource(newScheduledQueryResource, client,
+				framework.NewAttribute(names.AttrID, aws.ToString(v.Arn)),
+			))
+		}
+	}
+
+	return sweepResources, nil
+}
@@ -0,0 +1,149 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package timestreamquery
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/aws/aws-sdk-go-v2/aws"
+	"github.com/aws/aws-sdk-go-v2/service/timestreamquery"
+	awstypes "github.com/aws/aws-sdk-go-v2/service/timestreamquery/types"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/retry"
+	"github.com/hashicorp/terraform-provider-aws/internal/errs"
+	"github.com/hashicorp/terraform-provider-aws/internal/framework"
+	fwtypes "github.com/hashicorp/terraform-provider-aws/internal/framework/types"
+	"github.com/hashicorp/terraform-provider-aws/internal/tfresource"
+	"github.com/hashicorp/terraform-provider-aws/names"
+)
+
+// @FrameworkDataSource(name="Table")
+func newTableDataSource(context.Context) (datasource.DataSourceWithConfigure, error) {
+	d := &tableDataSource{}
+
+	return d, nil
+}
+
+type tableDataSource struct {
+	framework.DataSourceWithConfigure
+}
+
+func (*tableDataSource) Metadata(_ context.Context, request datasource.MetadataRequest, response *datasource.MetadataResponse) {
+	response.TypeName = "aws_timestreamquery_table"
+}
+
+func (d *tableDataSource) Schema(ctx context.Context, request datasource.SchemaRequest, response *datasource.SchemaResponse) {
+	response.Schema = schema.Schema{
+		Attributes: map[string]schema.Attribute{
+			"columns": schema.ListAttribute{
+				CustomType:  fwtypes.NewListNestedObjectTypeOf[tableColumnModel](ctx),
+				ElementType: fwtypes.NewObjectTypeOf[tableColumnModel](ctx),
+				Computed:    true,
+			},
+			names.AttrDatabaseName: schema.StringAttribute{
+				Required: true,
+			},
+			names.AttrID: framework.IDAttribute(),
+			names.AttrTableName: schema.StringAttribute{
+				Required: true,
+			},
+		},
+	}
+}
+
+func (d *tableDataSource) Read(ctx context.Context, request datasource.ReadRequest, response *datasource.ReadResponse) {
+	var data tableDataSourceModel
+	response.Diagnostics.Append(request.Config.Get(ctx, &data)...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	conn := d.Meta().TimestreamQueryClient(ctx)
+
+	databaseName, tableName := data.DatabaseName.ValueString(), data.TableName.ValueString()
+	id := databaseName + ":" + tableName
+	columns, err := findTableColumnsByTwoPartKey(ctx, conn, databaseName, tableName)
+
+	if err != nil {
+		response.Diagnostics.AddError(fmt.Sprintf("reading Timestream Query Table (%s)", id), tfresource.SingularDataSourceFindError("Timestream Query Table", err).Error())
+
+		return
+	}
+
+	columnsValue, diags := fwtypes.NewListNestedObjectValueOfValueSlice(ctx, columns)
+	response.Diagnostics.Append(diags...)
+	if response.Diagnostics.HasError() {
+		return
+	}
+
+	data.Columns = columnsValue
+	data.ID = types.StringValue(id)
+
+	response.Diagnostics.Append(response.State.Set(ctx, &data)...)
+}
+
+// findTableColumnsByTwoPartKey describes the specified table using the Timestream query language.
+// Each row of the result is a column name, its data type and its Timestream attribute type.
+func findTableColumnsByTwoPartKey(ctx context.Context, conn *timestreamquery.Client, databaseName, tableName string) ([]tableColumnModel, error) {
+	input := &timestreamquery.QueryInput{
+		QueryString: aws.String(fmt.Sprintf(`DESCRIBE %s.%s`, quoteIdentifier(databaseName), quoteIdentifier(tableName))),
+	}
+
+	var output []tableColumnModel
+
+	pages := timestreamquery.NewQueryPaginator(conn, input)
+	for pages.HasMorePages() {
+		page, err := pages.NextPage(ctx)
+
+		if errs.IsAErrorMessageContains[*awstypes.ValidationException](err, "does not exist") {
+			return nil, &retry.NotFoundError{
+				LastError:   err,
+				LastRequest: input,
+			}
+		}
+
+		if err != nil {
+			return nil, err
+		}
+
+		for _, row := range page.Rows {
+			if len(row.Data) < 3 {
+				continue
+			}
+
+			output = append(output, tableColumnModel{
+				Name:                    types.StringPointerValue(row.Data[0].ScalarValue),
+				TimestreamAttributeType: types.StringPointerValue(row.Data[2].ScalarValue),
+				Type:                    types.StringPointerValue(row.Data[1].ScalarValue),
+			})
+		}
+	}
+
+	if len(output) == 0 {
+		return nil, tfresource.NewEmptyResultError(input)
+	}
+
+	return output, nil
+}
+
+func quoteIdentifier(s string) string {
+	return `"` + strings.ReplaceAll(s, `"`, `""`) + `"`
+}
+
+type tableDataSourceModel struct {
+	Columns      fwtypes.ListNestedObjectValueOf[tableColumnModel] `tfsdk:"columns"`
+	DatabaseName types.String                                      `tfsdk:"database_name"`
+	ID           types.String                                      `tfsdk:"id"`
+	TableName    types.String                                      `tfsdk:"table_name"`
+}
+
+type tableColumnModel struct {
+	Name                    types.String `tfsdk:"name"`
+	TimestreamAttributeType types.String `tfsdk:"timestream_attribute_type"`
+	Type                    types.String `tfsdk:"type"`
+}
@@ -6,11 +6,13 @@ package sweep
 import (
 	"context"
 	"fmt"
+	"maps"
 	"os"
 	"strconv"
 	"time"
 
 	aws_sdkv2 "github.com/aws/aws-sdk-go-v2/aws"
+	awsbase "github.com/hashicorp/aws-sdk-go-base/v2"
 	multierror "github.com/hashicorp/go-multierror"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
@@ -54,14 +56,6 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 		}
 	}
 
-	meta := new(conns.AWSClient)
-	servicePackageMap := make(map[string]conns.ServicePackage)
-	for _, sp := range ServicePackages {
-		servicePackageName := sp.ServicePackageName()
-		servicePackageMap[servicePackageName] = sp
-	}
-	meta.ServicePackages = servicePackageMap
-
 	conf := &conns.Config{
 		Region:           region,
 		RetryMode:        aws_sdkv2.RetryModeAdaptive,
@@ -69,7 +63,9 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 	}
 
 	if role := os.Getenv(envvar.AssumeRoleARN); role != "" {
-		conf.AssumeRole.RoleARN = role
+		conf.AssumeRole = &awsbase.AssumeRole{
+			RoleARN: role,
+		}
 
 		conf.AssumeRole.Duration = time.Duration(defaultSweeperAssumeRoleDurationSeconds) * time.Second
 		if v := os.Getenv(envvar.AssumeRoleDuration); v != "" {
@@ -90,14 +86,33 @@ func SharedRegionalSweepClient(ctx context.Context, region string) (*conns.AWSCl
 	}
 
 	// configures a default client for the region, using the above env vars
+	client, err := NewClient(ctx, conf)
+
+	if err != nil {
+		return nil, err
+	}
+
+	sweeperClients[region] = client
+
+	return client, nil
+}
+
+// NewClient returns a new conns.AWSClient configured for sweeping using the specified configuration.
+func NewClient(ctx context.Context, conf *conns.Config) (*conns.AWSClient, error) {
+	meta := new(conns.AWSClient)
+	servicePackageMap := make(map[string]conns.ServicePackage)
+	for _, sp := range ServicePackages {
+		servicePackageName := sp.ServicePackageName()
+		servicePackageMap[servicePackageName] = sp
+	}
+	meta.ServicePackages = servicePackageMap
+
 	client, diags := conf.ConfigureProvider(ctx, meta)
 
 	if diags.HasError() {
 		return nil, fmt.Errorf("getting AWS client: %#v", diags)
 	}
 
-	sweeperClients[region] = client
-
 	return client, nil
 }
 
@@ -136,9 +151,36 @@ func PartitionDNSSuffix(region string) string {
 
 type SweeperFn func(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error)
 
+// Sweeper is a sweeper registered using Register.
+type Sweeper struct {
+	Name         string
+	Dependencies []string // Sweepers that must run before this sweeper.
+	F            SweeperFn
+}
+
+// sweepers holds the sweepers registered using Register, keyed by name.
+var sweepers = make(map[string]*Sweeper)
+
+// Sweepers returns the sweepers registered using Register, keyed by name.
+func Sweepers() map[string]*Sweeper {
+	return maps.Clone(sweepers)
+}
+
+// List returns the resources to be swept using the specified client.
+func (s *Sweeper) List(ctx context.Context, client *conns.AWSClient) ([]Sweepable, error) {
+	return s.F(logWithResourceType(ctx, s.Name), client)
+}
+
 func Register(name string, f SweeperFn, dependencies ...string) {
+	sweepers[name] = &Sweeper{
+		Name:         name,
+		Dependencies: dependencies,
+		F:            f,
+	}
+
 	resource.AddTestSweepers(name, &resource.Sweeper{
-		Name: name,
+		Name:         name,
+		Dependencies: dependencies,
 		F: func(region string) error {
 			ctx := Context(region)
 			ctx = logWithResourceType(ctx, name)
@@ -17,12 +17,22 @@ type ServicePackageResourceTags struct {
 	ResourceType        string // Extra resourceType parameter value for UpdateTags etc.
 }
 
+// ServicePackageResourceARN represents how a resource's native ID is resolved from its ARN on import.
+// Templates contain placeholders of the form "{name}".
+// The partition, service, region and account ID components of the ARN are always available as
+// "{partition}", "{service}", "{region}" and "{account_id}".
+type ServicePackageResourceARN struct {
+	Template   string // ARN template, e.g. "arn:{partition}:lambda:{region}:{account_id}:function:{function_name}".
+	IDTemplate string // Native ID template, e.g. "{function_name}". If empty the ARN is the native ID.
+}
+
 // ServicePackageFrameworkDataSource represents a Terraform Plugin Framework data source
 // implemented by a service package.
 type ServicePackageFrameworkDataSource struct {
-	Factory func(context.Context) (datasource.DataSourceWithConfigure, error)
-	Name    string
-	Tags    *ServicePackageResourceTags
+	Factory   func(context.Context) (datasource.DataSourceWithConfigure, error)
+	Name      string
+	Tags      *ServicePackageResourceTags
+	NoMemoize bool // Reads must never be memoized, e.g. the data source has side effects.
 }
 
 // ServicePackageFrameworkResource represents a Terraform Plugin Framework resource
@@ -31,15 +41,17 @@ type ServicePackageFrameworkResource struct {
 	Factory func(context.Context) (resource.ResourceWithConfigure, error)
 	Name    string
 	Tags    *ServicePackageResourceTags
+	ARN     *ServicePackageResourceARN
 }
 
 // ServicePackageSDKDataSource represents a Terraform Plugin SDK data source
 // implemented by a service package.
 type ServicePackageSDKDataSource struct {
-	Factory  func() *schema.Resource
-	TypeName string
-	Name     string
-	Tags     *ServicePackageResourceTags
+	Factory   func() *schema.Resource
+	TypeName  string
+	Name      string
+	Tags      *ServicePackageResourceTags
+	NoMemoize bool // Reads must never be memoized, e.g. the data source has side effects.
 }
 
 // ServicePackageSDKResource represents a Terraform Plugin SDK resource
@@ -49,4 +61,5 @@ type ServicePackageSDKResource struct {
 	TypeName string
 	Name     string
 	Tags     *ServicePackageResourceTags
+	ARN      *ServicePackageResourceARN
 }
@@ -0,0 +1,104 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"errors"
+	"fmt"
+	"regexp"
+	"strings"
+
+	"github.com/YakDriver/regexache"
+	"github.com/aws/aws-sdk-go-v2/aws/arn"
+)
+
+var arnTemplatePlaceholderRegexp = regexache.MustCompile(`\{([0-9A-Za-z_]+)\}`)
+
+// ResolveID returns the native resource ID corresponding to the specified ARN.
+func (t *ServicePackageResourceARN) ResolveID(v string) (string, error) {
+	have, err := arn.Parse(v)
+	if err != nil {
+		return "", err
+	}
+
+	want, err := arn.Parse(t.Template)
+	if err != nil {
+		return "", fmt.Errorf("parsing ARN template (%s): %w", t.Template, err)
+	}
+
+	// The standard ARN components are always available.
+	values := map[string]string{
+		"partition":  have.Partition,
+		"service":    have.Service,
+		"region":     have.Region,
+		"account_id": have.AccountID,
+	}
+	for _, c := range []struct {
+		template, value string
+	}{
+		{want.Partition, have.Partition},
+		{want.Service, have.Service},
+		{want.Region, have.Region},
+		{want.AccountID, have.AccountID},
+		{want.Resource, have.Resource},
+	} {
+		if !matchARNTemplateComponent(c.template, c.value, values) {
+			return "", fmt.Errorf("ARN (%s) does not match template (%s)", v, t.Template)
+		}
+	}
+
+	if t.IDTemplate == "" {
+		return v, nil
+	}
+
+	var errs []error
+	id := arnTemplatePlaceholderRegexp.ReplaceAllStringFunc(t.IDTemplate, func(s string) string {
+		name := s[1 : len(s)-1]
+		value, ok := values[name]
+		if !ok {
+			errs = append(errs, fmt.Errorf("ID template (%s) placeholder (%s) not found in ARN template (%s)", t.IDTemplate, name, t.Template))
+		}
+
+		return value
+	})
+
+	if err := errors.Join(errs...); err != nil {
+		return "", err
+	}
+
+	return id, nil
+}
+
+// matchARNTemplateComponent matches a single ARN component against its template, recording placeholder values.
+// Each placeholder matches the shortest non-empty text that allows the whole component to match.
+func matchARNTemplateComponent(template, value string, values map[string]string) bool {
+	var pattern strings.Builder
+	var names []string
+
+	pattern.WriteString(`^`)
+	last := 0
+	for _, loc := range arnTemplatePlaceholderRegexp.FindAllStringSubmatchIndex(template, -1) {
+		pattern.WriteString(regexp.QuoteMeta(template[last:loc[0]]))
+		pattern.WriteString(`(.+?)`)
+		names = append(names, template[loc[2]:loc[3]])
+		last = loc[1]
+	}
+	pattern.WriteString(regexp.QuoteMeta(template[last:]))
+	pattern.WriteString(`$`)
+
+	m := regexache.MustCompile(pattern.String()).FindStringSubmatch(value)
+	if m == nil {
+		return false
+	}
+
+	for i, name := range names {
+		// A repeated placeholder must have the same value everywhere.
+		if v, ok := values[name]; ok && v != m[i+1] {
+			return false
+		}
+		values[name] = m[i+1]
+	}
+
+	return true
+}
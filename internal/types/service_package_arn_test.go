@@ -0,0 +1,118 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package types
+
+import (
+	"testing"
+)
+
+func TestServicePackageResourceARNResolveID(t *testing.T) {
+	t.Parallel()
+
+	testCases := map[string]struct {
+		template    ServicePackageResourceARN
+		arn         string
+		expectedID  string
+		expectError bool
+	}{
+		"single placeholder": {
+			template: ServicePackageResourceARN{
+				Template:   "arn:{partition}:sns:{region}:{account_id}:{name}",
+				IDTemplate: "{name}",
+			},
+			arn:        "arn:aws:sns:us-west-2:123456789012:my-topic", //lintignore:AWSAT003,AWSAT005
+			expectedID: "my-topic",
+		},
+		"global resource": {
+			template: ServicePackageResourceARN{
+				Template:   "arn:{partition}:iam::{account_id}:role/{name}",
+				IDTemplate: "{name}",
+			},
+			arn:        "arn:aws:iam::123456789012:role/my-role", //lintignore:AWSAT005
+			expectedID: "my-role",
+		},
+		"multiple placeholders": {
+			template: ServicePackageResourceARN{
+				Template:   "arn:{partition}:dynamodb:{region}:{account_id}:table/{table}/stream/{label}",
+				IDTemplate: "{table}:{label}",
+			},
+			arn:        "arn:aws:dynamodb:us-west-2:123456789012:table/my-table/stream/2024-01-01T00:00:00.000", //lintignore:AWSAT003,AWSAT005
+			expectedID: "my-table:2024-01-01T00:00:00.000",
+		},
+		"standard components": {
+			template: ServicePackageResourceARN{
+				Template:   "arn:{partition}:sqs:{region}:{account_id}:{name}",
+				IDTemplate: "{region}/{account_id}/{name}",
+			},
+			arn:        "arn:aws:sqs:us-west-2:123456789012:my-queue", //lintignore:AWSAT003,AWSAT005
+			expectedID: "us-west-2/123456789012/my-queue",
+		},
+		"no ID template": {
+			template: ServicePackageResourceARN{
+				Template: "arn:{partition}:sns:{region}:{account_id}:{name}",
+			},
+			arn:        "arn:aws:sns:us-west-2:123456789012:my-topic", //lintignore:AWSAT003,AWSAT005
+			expectedID: "arn:aws:sns:us-west-2:123456789012:my-topic", //lintignore:AWSAT003,AWSAT005
+		},
+		"service mismatch": {
+			template: ServicePackageResourceARN{
+				Template:   "arn:{partition}:sns:{region}:{account_id}:{name}",
+				IDTemplate: "{name}",
+			},
+			arn:         "arn:aws:sqs:us-west-2:123456789012:my-queue", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+		"resource mismatch": {
+			template: ServicePackageResourceARN{
+				Template:   "arn:{partition}:iam::{account_id}:role/{name}",
+				IDTemplate: "{name}",
+			},
+			arn:         "arn:aws:iam::123456789012:user/my-user", //lintignore:AWSAT005
+			expectError: true,
+		},
+		"regional template global ARN": {
+			template: ServicePackageResourceARN{
+				Template:   "arn:{partition}:sns:{region}:{account_id}:{name}",
+				IDTemplate: "{name}",
+			},
+			arn:         "arn:aws:sns::123456789012:my-topic", //lintignore:AWSAT005
+			expectError: true,
+		},
+		"not an ARN": {
+			template: ServicePackageResourceARN{
+				Template:   "arn:{partition}:sns:{region}:{account_id}:{name}",
+				IDTemplate: "{name}",
+			},
+			arn:         "my-topic",
+			expectError: true,
+		},
+		"unknown ID template placeholder": {
+			template: ServicePackageResourceARN{
+				Template:   "arn:{partition}:sns:{region}:{account_id}:{name}",
+				IDTemplate: "{topic_name}",
+			},
+			arn:         "arn:aws:sns:us-west-2:123456789012:my-topic", //lintignore:AWSAT003,AWSAT005
+			expectError: true,
+		},
+	}
+
+	for name, testCase := range testCases {
+		testCase := testCase
+		t.Run(name, func(t *testing.T) {
+			t.Parallel()
+
+			got, err := testCase.template.ResolveID(testCase.arn)
+
+			if got, want := err != nil, testCase.expectError; got != want {
+				t.Fatalf("ResolveID(%q) err %t, want %t (%v)", testCase.arn, got, want, err)
+			}
+
+			if err == nil {
+				if got, want := got, testCase.expectedID; got != want {
+					t.Errorf("ResolveID(%q) = %q, want %q", testCase.arn, got, want)
+				}
+			}
+		})
+	}
+}
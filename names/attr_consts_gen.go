@@ -62,6 +62,8 @@ const (
 	AttrEncryptionConfiguration    = "encryption_configuration"
 	AttrEndpoint                   = "endpoint"
 	AttrEndpointType               = "endpoint_type"
+	AttrEndpointURL                = "endpoint_url"
+	AttrEndpointURLTemplate        = "endpoint_url_template"
 	AttrEndpoints                  = "endpoints"
 	AttrEngineVersion              = "engine_version"
 	AttrEnvironment                = "environment"
@@ -260,6 +262,8 @@ func ConstOrQuote(constant string) string {
 		"encryption_configuration":      "AttrEncryptionConfiguration",
 		"endpoint":                      "AttrEndpoint",
 		"endpoint_type":                 "AttrEndpointType",
+		"endpoint_url":                  "AttrEndpointURL",
+		"endpoint_url_template":         "AttrEndpointURLTemplate",
 		"endpoints":                     "AttrEndpoints",
 		"engine_version":                "AttrEngineVersion",
 		"environment":                   "AttrEnvironment",
@@ -194,6 +194,7 @@ const (
 	S3                           = "s3"
 	S3Control                    = "s3control"
 	S3Outposts                   = "s3outposts"
+	S3Tables                     = "s3tables"
 	SES                          = "ses"
 	SESV2                        = "sesv2"
 	SFN                          = "sfn"
@@ -223,6 +224,8 @@ const (
 	SimpleDB                     = "simpledb"
 	StorageGateway               = "storagegateway"
 	Synthetics                   = "synthetics"
+	TimestreamInfluxDB           = "timestreaminfluxdb"
+	TimestreamQuery              = "timestreamquery"
 	TimestreamWrite              = "timestreamwrite"
 	Transcribe                   = "transcribe"
 	Transfer                     = "transfer"
@@ -433,6 +436,7 @@ const (
 	S3ServiceID                           = "S3"
 	S3ControlServiceID                    = "S3 Control"
 	S3OutpostsServiceID                   = "S3Outposts"
+	S3TablesServiceID                     = "S3Tables"
 	SESServiceID                          = "SES"
 	SESV2ServiceID                        = "SESv2"
 	SFNServiceID                          = "SFN"
@@ -462,6 +466,8 @@ const (
 	SimpleDBServiceID                     = "SimpleDB"
 	StorageGatewayServiceID               = "Storage Gateway"
 	SyntheticsServiceID                   = "synthetics"
+	TimestreamInfluxDBServiceID           = "Timestream InfluxDB"
+	TimestreamQueryServiceID              = "Timestream Query"
 	TimestreamWriteServiceID              = "Timestream Write"
 	TranscribeServiceID                   = "Transcribe"
 	TransferServiceID                     = "Transfer"
@@ -163,6 +163,10 @@ const (
 )
 
 func DNSSuffixForPartition(partition string) string {
+	if p, ok := customPartitionForID(partition); ok {
+		return p.DNSSuffix
+	}
+
 	switch partition {
 	case "":
 		return ""
@@ -199,6 +203,10 @@ func IsOptInRegion(region string) bool {
 }
 
 func PartitionForRegion(region string) string {
+	if p, _, ok := customPartitionForRegion(region); ok {
+		return p.ID
+	}
+
 	switch region {
 	case "":
 		return ""
@@ -0,0 +1,201 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package names
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"regexp"
+	"sync"
+)
+
+// PartitionsFileEnvVar is the environment variable naming a custom partitions file.
+// The provider's `partitions_file` argument takes precedence.
+const PartitionsFileEnvVar = "TF_AWS_PARTITIONS_FILE"
+
+// Custom partition metadata, e.g. for isolated regions unknown to the provider.
+// The file format follows the AWS SDK endpoints.json "partitions" document
+// with optional per-region extensions:
+//
+//	{
+//	  "partitions": [{
+//	    "partition": "aws-iso-x",
+//	    "dnsSuffix": "example.ic.gov",
+//	    "regionRegex": "^us\\-isox\\-\\w+\\-\\d+$",
+//	    "regions": {
+//	      "us-isox-east-1": {
+//	        "description": "US ISOX East",
+//	        "ec2PrivateDnsSuffix": "ec2.internal",
+//	        "hostedZoneIds": {"elb": "Z1234567890ABC"}
+//	      }
+//	    }
+//	  }]
+//	}
+type customPartitionsDocument struct {
+	Partitions []customPartition `json:"partitions"`
+}
+
+type customPartition struct {
+	DNSSuffix   string                  `json:"dnsSuffix"`
+	ID          string                  `json:"partition"`
+	RegionRegex string                  `json:"regionRegex"`
+	Regions     map[string]customRegion `json:"regions"`
+
+	regionRegex *regexp.Regexp
+}
+
+type customRegion struct {
+	Description         string            `json:"description"`
+	EC2PrivateDNSSuffix string            `json:"ec2PrivateDnsSuffix"`
+	HostedZoneIDs       map[string]string `json:"hostedZoneIds"`
+}
+
+var (
+	customPartitions     []customPartition
+	customPartitionsLock sync.RWMutex
+	customPartitionsOnce sync.Once
+)
+
+// LoadPartitionsFile reads custom partition metadata from the specified file.
+// Partitions in the file take precedence over the partitions known to the provider.
+func LoadPartitionsFile(filename string) error {
+	partitions, err := readPartitionsFile(filename)
+	if err != nil {
+		return err
+	}
+
+	customPartitionsOnce.Do(func() {}) // Explicit configuration wins over the environment.
+
+	customPartitionsLock.Lock()
+	defer customPartitionsLock.Unlock()
+
+	customPartitions = partitions
+
+	return nil
+}
+
+func readPartitionsFile(filename string) ([]customPartition, error) {
+	b, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("reading partitions file (%s): %w", filename, err)
+	}
+
+	partitions, err := parsePartitions(b)
+	if err != nil {
+		return nil, fmt.Errorf("parsing partitions file (%s): %w", filename, err)
+	}
+
+	return partitions, nil
+}
+
+func parsePartitions(b []byte) ([]customPartition, error) {
+	var doc customPartitionsDocument
+
+	if err := json.Unmarshal(b, &doc); err != nil {
+		return nil, err
+	}
+
+	for i, p := range doc.Partitions {
+		if p.ID == "" {
+			return nil, fmt.Errorf("partition %d: missing partition ID", i)
+		}
+		if p.DNSSuffix == "" {
+			return nil, fmt.Errorf("partition (%s): missing DNS suffix", p.ID)
+		}
+		if p.RegionRegex != "" {
+			re, err := regexp.Compile(p.RegionRegex)
+			if err != nil {
+				return nil, fmt.Errorf("partition (%s): region regex: %w", p.ID, err)
+			}
+			doc.Partitions[i].regionRegex = re
+		}
+	}
+
+	return doc.Partitions, nil
+}
+
+// loadPartitionsFromEnv loads custom partitions from the file named by the TF_AWS_PARTITIONS_FILE environment variable.
+// This allows code running before provider configuration, e.g. provider-defined functions, to resolve custom partitions.
+func loadPartitionsFromEnv() {
+	customPartitionsOnce.Do(func() {
+		if filename := os.Getenv(PartitionsFileEnvVar); filename != "" {
+			// Errors are reported during provider configuration.
+			partitions, err := readPartitionsFile(filename)
+			if err != nil {
+				return
+			}
+
+			customPartitionsLock.Lock()
+			defer customPartitionsLock.Unlock()
+
+			customPartitions = partitions
+		}
+	})
+}
+
+// customPartitionForRegion returns any custom partition containing the specified region.
+// Explicitly listed regions take precedence over region regular expressions.
+func customPartitionForRegion(region string) (*customPartition, *customRegion, bool) {
+	loadPartitionsFromEnv()
+
+	customPartitionsLock.RLock()
+	defer customPartitionsLock.RUnlock()
+
+	for i, p := range customPartitions {
+		if r, ok := p.Regions[region]; ok {
+			return &customPartitions[i], &r, true
+		}
+	}
+
+	for i, p := range customPartitions {
+		if p.regionRegex != nil && p.regionRegex.MatchString(region) {
+			return &customPartitions[i], nil, true
+		}
+	}
+
+	return nil, nil, false
+}
+
+func customPartitionForID(partition string) (*customPartition, bool) {
+	loadPartitionsFromEnv()
+
+	customPartitionsLock.RLock()
+	defer customPartitionsLock.RUnlock()
+
+	for i, p := range customPartitions {
+		if p.ID == partition {
+			return &customPartitions[i], true
+		}
+	}
+
+	return nil, false
+}
+
+// IsCustomRegion returns whether the specified region is defined in a custom partitions file.
+func IsCustomRegion(region string) bool {
+	_, _, ok := customPartitionForRegion(region)
+	return ok
+}
+
+// EC2PrivateDNSSuffixForCustomRegion returns any custom EC2 private DNS suffix for the specified region.
+func EC2PrivateDNSSuffixForCustomRegion(region string) (string, bool) {
+	if _, r, ok := customPartitionForRegion(region); ok && r != nil && r.EC2PrivateDNSSuffix != "" {
+		return r.EC2PrivateDNSSuffix, true
+	}
+
+	return "", false
+}
+
+// HostedZoneIDForCustomRegion returns any custom Route 53 hosted zone ID for the specified service and region.
+// Service keys are `elb` (Classic Load Balancers), `alb` (Application Load Balancers), `nlb` (Network Load Balancers) and `s3`.
+func HostedZoneIDForCustomRegion(service, region string) (string, bool) {
+	if _, r, ok := customPartitionForRegion(region); ok && r != nil {
+		if v, ok := r.HostedZoneIDs[service]; ok && v != "" {
+			return v, true
+		}
+	}
+
+	return "", false
+}
@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package names
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+const testPartitionsFile = `{
+  "partitions": [{
+    "partition": "aws-iso-x",
+    "dnsSuffix": "x.example.ic.gov",
+    "regionRegex": "^us\\-isox\\-\\w+\\-\\d+$",
+    "regions": {
+      "us-isox-east-1": {
+        "description": "US ISOX East",
+        "ec2PrivateDnsSuffix": "ec2.isox.internal",
+        "hostedZoneIds": {
+          "elb": "Z1234567890ELB",
+          "s3": "Z1234567890S3"
+        }
+      }
+    }
+  }]
+}`
+
+func TestLoadPartitionsFile(t *testing.T) { //nolint:paralleltest // modifies package state
+	filename := filepath.Join(t.TempDir(), "partitions.json")
+	if err := os.WriteFile(filename, []byte(testPartitionsFile), 0600); err != nil {
+		t.Fatal(err)
+	}
+
+	if err := LoadPartitionsFile(filename); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	t.Cleanup(func() {
+		customPartitionsLock.Lock()
+		defer customPartitionsLock.Unlock()
+
+		customPartitions = nil
+	})
+
+	testCases := []struct {
+		name     string
+		got      string
+		expected string
+	}{
+		{
+			name:     "partition for listed region",
+			got:      PartitionForRegion("us-isox-east-1"),
+			expected: "aws-iso-x",
+		},
+		{
+			name:     "partition for region matching regex",
+			got:      PartitionForRegion("us-isox-west-2"),
+			expected: "aws-iso-x",
+		},
+		{
+			name:     "partition for known region",
+			got:      PartitionForRegion(CNNorth1RegionID),
+			expected: ChinaPartitionID,
+		},
+		{
+			name:     "DNS suffix for custom partition",
+			got:      DNSSuffixForPartition("aws-iso-x"),
+			expected: "x.example.ic.gov",
+		},
+		{
+			name:     "DNS suffix for known partition",
+			got:      DNSSuffixForPartition(ChinaPartitionID),
+			expected: "amazonaws.com.cn",
+		},
+	}
+
+	for _, testCase := range testCases {
+		if testCase.got != testCase.expected {
+			t.Errorf("%s: got: %s, expected: %s", testCase.name, testCase.got, testCase.expected)
+		}
+	}
+
+	if !IsCustomRegion("us-isox-east-1") {
+		t.Errorf("expected us-isox-east-1 to be a custom region")
+	}
+	if IsCustomRegion(USEast1RegionID) {
+		t.Errorf("expected %s not to be a custom region", USEast1RegionID)
+	}
+
+	if got, ok := EC2PrivateDNSSuffixForCustomRegion("us-isox-east-1"); !ok || got != "ec2.isox.internal" {
+		t.Errorf("EC2 private DNS suffix: got: %s, expected: %s", got, "ec2.isox.internal")
+	}
+	if _, ok := EC2PrivateDNSSuffixForCustomRegion("us-isox-west-2"); ok {
+		t.Errorf("EC2 private DNS suffix: expected none for region matching regex")
+	}
+
+	if got, ok := HostedZoneIDForCustomRegion("elb", "us-isox-east-1"); !ok || got != "Z1234567890ELB" {
+		t.Errorf("hosted zone ID: got: %s, expected: %s", got, "Z1234567890ELB")
+	}
+	if _, ok := HostedZoneIDForCustomRegion("nlb", "us-isox-east-1"); ok {
+		t.Errorf("hosted zone ID: expected none for nlb")
+	}
+}
+
+func TestLoadPartitionsFile_invalid(t *testing.T) { //nolint:paralleltest // modifies package state
+	testCases := map[string]string{
+		"invalid JSON":         `{`,
+		"missing partition":    `{"partitions": [{"dnsSuffix": "example.com"}]}`,
+		"missing DNS suffix":   `{"partitions": [{"partition": "aws-iso-x"}]}`,
+		"invalid region regex": `{"partitions": [{"partition": "aws-iso-x", "dnsSuffix": "example.com", "regionRegex": "("}]}`,
+	}
+
+	for name, content := range testCases {
+		filename := filepath.Join(t.TempDir(), "partitions.json")
+		if err := os.WriteFile(filename, []byte(content), 0600); err != nil {
+			t.Fatal(err)
+		}
+
+		if err := LoadPartitionsFile(filename); err == nil {
+			t.Errorf("%s: expected error", name)
+		}
+	}
+
+	if err := LoadPartitionsFile(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Errorf("missing file: expected error")
+	}
+}